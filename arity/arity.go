@@ -79,3 +79,65 @@ func Optional(offset int, limit int, def interface{}, args ...interface{}) inter
 		return def
 	}
 }
+
+// Exact ensures that args contains precisely n elements, returning args for convenience. If the number of
+// elements differs from n, the function panics.
+func Exact(n int, args ...interface{}) []interface{} {
+	if length := len(args); length != n {
+		panic(fmt.Errorf("expected exactly %d argument(s), got %d", n, length))
+	}
+	return args
+}
+
+// TailOptions returns args unchanged if it contains at most max elements, and an error otherwise. It is the
+// non-panicking complement of Optional, validating the optional tail of a variadic function at a library boundary,
+// where a misuse is better reported to the caller than raised as a panic. For example:
+//
+//	func f(required string, opts ...interface{}) error {
+//		opts, err := arity.TailOptions(2, opts)
+//		...
+//	}
+//
+// An error is also returned if max is negative.
+func TailOptions(max int, args []interface{}) ([]interface{}, error) {
+	switch length := len(args); {
+	case max < 0:
+		return nil, fmt.Errorf("max must not be negative")
+	case length > max:
+		return nil, fmt.Errorf("expected at most %d optional argument(s), got %d", max, length)
+	default:
+		return args, nil
+	}
+}
+
+// OneOf is a variation of SoleUntyped that additionally validates the sole argument against a set of allowed
+// values, panicking if the argument is present but not allowed. If args is empty, def is returned without
+// validation. Useful for enforcing enum-like optional arguments.
+func OneOf(def interface{}, allowed []interface{}, args interface{}) interface{} {
+	list := ListifyOrPanic(args)
+	arg := Sole(def, list...)
+	if len(list) == 0 {
+		return arg
+	}
+	for _, candidate := range allowed {
+		if reflect.DeepEqual(arg, candidate) {
+			return arg
+		}
+	}
+	panic(fmt.Errorf("unsupported argument %v; expected one of %v", arg, allowed))
+}
+
+// Coalesce returns the first value that is neither nil nor the zero value of its type (as determined by
+// reflect.Value.IsZero), or the last value if all are zero. Returns nil if no values are given. Useful for selecting
+// among a sequence of candidate defaults.
+func Coalesce(values ...interface{}) interface{} {
+	for _, value := range values {
+		if value != nil && !reflect.ValueOf(value).IsZero() {
+			return value
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	return values[len(values)-1]
+}
@@ -98,3 +98,98 @@ func TestOptionalUntyped(t *testing.T) {
 		}
 	}
 }
+
+func TestExact_exact(t *testing.T) {
+	assert.Equal(t, []interface{}{"a", "b"}, Exact(2, "a", "b"))
+	assert.Empty(t, Exact(0))
+}
+
+func TestExact_tooFew(t *testing.T) {
+	check.ThatPanicsAsExpected(t, check.ErrorWithValue("expected exactly 2 argument(s), got 1"), func() {
+		Exact(2, "a")
+	})
+}
+
+func TestExact_tooMany(t *testing.T) {
+	check.ThatPanicsAsExpected(t, check.ErrorWithValue("expected exactly 2 argument(s), got 3"), func() {
+		Exact(2, "a", "b", "c")
+	})
+}
+
+var allowedModes = []interface{}{"fast", "safe"}
+
+func TestTailOptions_withinLimit(t *testing.T) {
+	opts, err := TailOptions(2, []interface{}{"a", 1})
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{"a", 1}, opts)
+
+	opts, err = TailOptions(2, nil)
+	assert.Nil(t, err)
+	assert.Empty(t, opts)
+
+	opts, err = TailOptions(0, []interface{}{})
+	assert.Nil(t, err)
+	assert.Empty(t, opts)
+}
+
+func TestTailOptions_overLimit(t *testing.T) {
+	opts, err := TailOptions(1, []interface{}{"a", "b", "c"})
+	assert.Nil(t, opts)
+	assert.EqualError(t, err, "expected at most 1 optional argument(s), got 3")
+
+	opts, err = TailOptions(0, []interface{}{"a"})
+	assert.Nil(t, opts)
+	assert.EqualError(t, err, "expected at most 0 optional argument(s), got 1")
+}
+
+func TestTailOptions_negativeMax(t *testing.T) {
+	opts, err := TailOptions(-1, nil)
+	assert.Nil(t, opts)
+	assert.EqualError(t, err, "max must not be negative")
+}
+
+func TestOneOf_default(t *testing.T) {
+	assert.Equal(t, "safe", OneOf("safe", allowedModes, []string{}))
+}
+
+func TestOneOf_valid(t *testing.T) {
+	assert.Equal(t, "fast", OneOf("safe", allowedModes, []string{"fast"}))
+}
+
+func TestOneOf_invalid(t *testing.T) {
+	check.ThatPanicsAsExpected(t, check.ErrorWithValue("unsupported argument reckless; expected one of [fast safe]"), func() {
+		OneOf("safe", allowedModes, []string{"reckless"})
+	})
+}
+
+func TestOneOf_tooMany(t *testing.T) {
+	check.ThatPanicsAsExpected(t, check.ErrorWithValue("expected at most 1 argument(s), got 2"), func() {
+		OneOf("safe", allowedModes, []string{"fast", "safe"})
+	})
+}
+
+func TestCoalesce(t *testing.T) {
+	type point struct{ x, y int }
+	var nilPtr *int
+	one := 1
+
+	cases := []struct {
+		values []interface{}
+		expect interface{}
+	}{
+		{values: nil, expect: nil},
+		{values: []interface{}{nil, "a", "b"}, expect: "a"},
+		{values: []interface{}{"", "a"}, expect: "a"},
+		{values: []interface{}{0, 0, 5}, expect: 5},
+		{values: []interface{}{0.0, 1.5}, expect: 1.5},
+		{values: []interface{}{false, true}, expect: true},
+		{values: []interface{}{point{}, point{1, 2}}, expect: point{1, 2}},
+		{values: []interface{}{nilPtr, &one}, expect: &one},
+		{values: []interface{}{nil, "", 0}, expect: 0},
+		{values: []interface{}{"", nil}, expect: nil},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.expect, Coalesce(c.values...), "for case %v", c)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 )
 
 const mockTesterStackDepth = 2
@@ -27,6 +28,7 @@ type TestCapture interface {
 	Capture(index int) SingleCapture
 	Captures() []SingleCapture
 	Length() int
+	WaitForLength(t Tester, n int, timeout time.Duration) bool
 	Reset()
 }
 
@@ -100,6 +102,18 @@ func (c *testCapture) Length() int {
 	return len(c.captured)
 }
 
+// WaitForLength blocks until the number of captured invocations reaches at least n, or the timeout elapses,
+// returning true if the length was reached. This is useful when invocations are captured from multiple
+// goroutines, where asserting on Length() directly would race with in-flight captures. If the length is not
+// reached in time, an error is reported to the given Tester.
+func (c *testCapture) WaitForLength(t Tester, n int, timeout time.Duration) bool {
+	return Wait(t, timeout).UntilAsserted(func(t Tester) {
+		if length := c.Length(); length < n {
+			t.Errorf("Expected at least %d captures; got %d", n, length)
+		}
+	})
+}
+
 // Resets TestCapture to its initial (blank) state.
 func (c *testCapture) Reset() {
 	c.lock.Lock()
@@ -137,14 +151,14 @@ func (s *singleCapture) NumCapturedLines() int {
 // take place.
 func (s *singleCapture) AssertNil(t Tester) {
 	if s.captured != nil {
-		t.Errorf("Expected nil; got '%v'%s", *s.captured, PrintStack(mockTesterStackDepth))
+		t.Errorf("Expected nil; got '%v'%s", *s.captured, PrintStack(mockTesterStackDepth+StackOffset))
 	}
 }
 
 // AssetNotNil checks that something was captured, without being concerned with the contents of the capture.
 func (s *singleCapture) AssertNotNil(t Tester) {
 	if s.captured == nil {
-		t.Errorf("Expected not nil%s", PrintStack(mockTesterStackDepth))
+		t.Errorf("Expected not nil%s", PrintStack(mockTesterStackDepth+StackOffset))
 	}
 }
 
@@ -153,12 +167,12 @@ func (s *singleCapture) AssertNotNil(t Tester) {
 // line, and it's often not practical to test these lines.
 func (s *singleCapture) AssertFirstLineEqual(t Tester, expected string) {
 	if s.captured == nil {
-		t.Errorf("Expected '%s'; got nil%s", expected, PrintStack(mockTesterStackDepth))
+		t.Errorf("Expected '%s'; got nil%s", expected, PrintStack(mockTesterStackDepth+StackOffset))
 		return
 	}
 
 	if lines := s.CapturedLines(); lines[0] != expected {
-		t.Errorf("Expected '%s'; got '%s'%s", expected, lines[0], PrintStack(mockTesterStackDepth))
+		t.Errorf("Expected '%s'; got '%s'%s", expected, lines[0], PrintStack(mockTesterStackDepth+StackOffset))
 	}
 }
 
@@ -166,23 +180,23 @@ func (s *singleCapture) AssertFirstLineEqual(t Tester, expected string) {
 // AssertFirstLineEqual, this is useful for verifying multi-line assertion messages.
 func (s *singleCapture) AssertFirstLineContains(t Tester, substr string) {
 	if s.captured == nil {
-		t.Errorf("Expected string containing '%s'; got nil%s", substr, PrintStack(mockTesterStackDepth))
+		t.Errorf("Expected string containing '%s'; got nil%s", substr, PrintStack(mockTesterStackDepth+StackOffset))
 		return
 	}
 
 	if lines := s.CapturedLines(); !strings.Contains(lines[0], substr) {
-		t.Errorf("Expected string containing '%s'; got '%s'%s", substr, lines[0], PrintStack(mockTesterStackDepth))
+		t.Errorf("Expected string containing '%s'; got '%s'%s", substr, lines[0], PrintStack(mockTesterStackDepth+StackOffset))
 	}
 }
 
 // AssertContains checks that the captured message contains the given substring.
 func (s *singleCapture) AssertContains(t Tester, substr string) {
 	if s.captured == nil {
-		t.Errorf("Expected string containing '%s'; got nil%s", substr, PrintStack(mockTesterStackDepth))
+		t.Errorf("Expected string containing '%s'; got nil%s", substr, PrintStack(mockTesterStackDepth+StackOffset))
 		return
 	}
 
 	if !strings.Contains(*s.captured, substr) {
-		t.Errorf("Expected string containing '%s'; got '%s'%s", substr, *s.captured, PrintStack(mockTesterStackDepth))
+		t.Errorf("Expected string containing '%s'; got '%s'%s", substr, *s.captured, PrintStack(mockTesterStackDepth+StackOffset))
 	}
 }
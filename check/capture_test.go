@@ -1,7 +1,10 @@
 package check
 
 import (
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -93,3 +96,54 @@ func TestMultipleCaptures(t *testing.T) {
 	c.Captures()[0].AssertFirstLineEqual(t, "One 1")
 	c.Captures()[1].AssertFirstLineEqual(t, "Two 2")
 }
+
+func TestWaitForLength_concurrentCaptures(t *testing.T) {
+	c := NewTestCapture()
+	const goroutines = 3
+	wg := sync.WaitGroup{}
+	wg.Add(goroutines)
+	for i := 0; i < goroutines; i++ {
+		go func(i int) {
+			defer wg.Done()
+			time.Sleep(1 * time.Millisecond)
+			c.Errorf("error %d", i)
+		}(i)
+	}
+
+	assert.True(t, c.WaitForLength(t, goroutines, 10*time.Second))
+	assert.Equal(t, goroutines, c.Length())
+	wg.Wait()
+}
+
+func TestWaitForLength_timeout(t *testing.T) {
+	c := NewTestCapture()
+	c.Errorf("error")
+
+	outer := NewTestCapture()
+	assert.False(t, c.WaitForLength(outer, 2, 1*time.Millisecond))
+	outer.First().AssertFirstLineContains(t, "Expected at least 2 captures; got 1")
+}
+
+func assertNotNilViaHelper(t Tester, c TestCapture) {
+	c.First().AssertNotNil(t)
+}
+
+func TestStackOffset(t *testing.T) {
+	defer func(offset int) { StackOffset = offset }(StackOffset)
+	c := NewTestCapture()
+
+	lines := make([][]string, 2)
+	for offset := range lines {
+		StackOffset = offset
+		g := NewTestCapture()
+		assertNotNilViaHelper(g, c)
+		lines[offset] = g.First().CapturedLines()
+	}
+
+	// With the offset, the trace begins one frame further up: at the helper's caller.
+	assert.Equal(t, "Expected not nil", lines[1][0])
+	assert.Equal(t, len(lines[0])-1, len(lines[1]))
+	assert.True(t, strings.HasPrefix(lines[0][1], "> capture_test.go:"), lines[0][1])
+	assert.NotEqual(t, lines[0][1], lines[1][1])
+	assert.Equal(t, strings.TrimPrefix(lines[0][2], "  "), strings.TrimPrefix(lines[1][1], "> "))
+}
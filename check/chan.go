@@ -0,0 +1,65 @@
+package check
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ChanReceives asserts that a value is received from the given channel within the timeout. The channel may be of
+// any element type, but must permit receiving; otherwise, this function panics. Returns the received value and true
+// if a value arrived. Failing that, the test is failed and nil and false are returned; this includes the case where
+// the channel is closed.
+func ChanReceives(t Tester, ch interface{}, timeout time.Duration) (interface{}, bool) {
+	value, ok, received := receive(ch, timeout)
+	switch {
+	case !received:
+		assert.Fail(t, fmt.Sprintf("Nothing received within %v", timeout))
+		return nil, false
+	case !ok:
+		assert.Fail(t, "Channel closed")
+		return nil, false
+	default:
+		return value, true
+	}
+}
+
+// ChanBlocks asserts that nothing is received from the given channel for the specified duration, returning true if
+// this is the case. The channel may be of any element type, but must permit receiving; otherwise, this function
+// panics. A closed channel does not block, and so fails the assertion.
+func ChanBlocks(t Tester, ch interface{}, duration time.Duration) bool {
+	value, ok, received := receive(ch, duration)
+	switch {
+	case !received:
+		return true
+	case !ok:
+		return assert.Fail(t, "Channel closed")
+	default:
+		return assert.Fail(t, fmt.Sprintf("Unexpectedly received %v", value))
+	}
+}
+
+// Receives from a channel of any type, waiting up to the given timeout. Returns the value, whether the channel was
+// open (as per the comma-ok idiom), and whether the receive completed before the timeout.
+func receive(ch interface{}, timeout time.Duration) (interface{}, bool, bool) {
+	chValue := reflect.ValueOf(ch)
+	if chValue.Kind() != reflect.Chan || chValue.Type().ChanDir()&reflect.RecvDir == 0 {
+		panic(fmt.Errorf("expected a receivable channel; got %T", ch))
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	chosen, value, ok := reflect.Select([]reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: chValue},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(timer.C)},
+	})
+	if chosen != 0 {
+		return nil, false, false
+	}
+	if !ok {
+		return nil, false, true
+	}
+	return value.Interface(), true, true
+}
@@ -0,0 +1,83 @@
+package check
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChanReceives_int(t *testing.T) {
+	ch := make(chan int, 1)
+	ch <- 42
+
+	c := NewTestCapture()
+	value, ok := ChanReceives(c, ch, 10*time.Second)
+	assert.True(t, ok)
+	assert.Equal(t, 42, value)
+	c.First().AssertNil(t)
+
+	value, ok = ChanReceives(c, ch, time.Millisecond)
+	assert.False(t, ok)
+	assert.Nil(t, value)
+	c.First().AssertContains(t, "Nothing received within 1ms")
+}
+
+func TestChanReceives_structDelayed(t *testing.T) {
+	ch := make(chan struct{})
+	go func() {
+		time.Sleep(time.Millisecond)
+		ch <- struct{}{}
+	}()
+
+	c := NewTestCapture()
+	value, ok := ChanReceives(c, ch, 10*time.Second)
+	assert.True(t, ok)
+	assert.Equal(t, struct{}{}, value)
+	c.First().AssertNil(t)
+}
+
+func TestChanReceives_closed(t *testing.T) {
+	ch := make(chan struct{})
+	close(ch)
+
+	c := NewTestCapture()
+	value, ok := ChanReceives(c, (<-chan struct{})(ch), 10*time.Second)
+	assert.False(t, ok)
+	assert.Nil(t, value)
+	c.First().AssertContains(t, "Channel closed")
+}
+
+func TestChanBlocks(t *testing.T) {
+	ch := make(chan int, 1)
+
+	c := NewTestCapture()
+	assert.True(t, ChanBlocks(c, ch, time.Millisecond))
+	c.First().AssertNil(t)
+
+	ch <- 42
+	assert.False(t, ChanBlocks(c, ch, 10*time.Second))
+	c.First().AssertContains(t, "Unexpectedly received 42")
+
+	c.Reset()
+	close(ch)
+	assert.False(t, ChanBlocks(c, ch, 10*time.Second))
+	c.First().AssertContains(t, "Channel closed")
+}
+
+func TestChanBlocks_struct(t *testing.T) {
+	ch := make(chan struct{})
+
+	c := NewTestCapture()
+	assert.True(t, ChanBlocks(c, ch, time.Millisecond))
+	c.First().AssertNil(t)
+}
+
+func TestChan_notReceivable(t *testing.T) {
+	ThatPanicsAsExpected(t, ErrorWithValue("expected a receivable channel; got int"), func() {
+		ChanBlocks(t, 42, time.Millisecond)
+	})
+	ThatPanicsAsExpected(t, ErrorWithValue("expected a receivable channel; got chan<- int"), func() {
+		ChanReceives(t, make(chan<- int), time.Millisecond)
+	})
+}
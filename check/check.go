@@ -17,6 +17,12 @@ type Tester interface {
 	Errorf(format string, args ...interface{})
 }
 
+// StackOffset is the number of additional stack frames skipped by the traces that are appended to failed
+// assertions (including those of TestCapture, Timesert, AddStack and the scribe mocks). By default, a trace begins
+// at the frame that invoked the assertion; authors of helpers that wrap these assertions may increase StackOffset
+// so that the trace begins at the helper's caller instead. It should be set before any assertions are made.
+var StackOffset = 0
+
 // PrintStack prints the call stack, starting from the given depth.
 func PrintStack(depth int) string {
 	var str strings.Builder
@@ -107,20 +113,33 @@ type Timesert interface {
 	UntilAsserted(a Assertion) bool
 }
 
+// ObservableTimesert is a Timesert that additionally accepts an Observer. It is a separate interface so that
+// existing implementations of Timesert remain valid.
+type ObservableTimesert interface {
+	Timesert
+	Observe(o Observer) Timesert
+}
+
 type timesert struct {
 	t        Tester
 	timeout  time.Duration
 	interval time.Duration
+	observer Observer
 }
 
+// Observer is notified after each poll of a Timesert, receiving the attempt number (starting at 1), the time
+// elapsed since the first attempt began, and whether the attempt passed. It is useful for recording timing metrics
+// to help tune check intervals and triage flaky tests.
+type Observer func(attempt int, elapsed time.Duration, passed bool)
+
 // DefaultWaitCheckInterval is the default value of the optional check interval
 // passed to Wait().
 const DefaultWaitCheckInterval = 1 * time.Millisecond
 
 // Wait returns a Timesert object will block for up to the given timeout.
 // The third argument is optional, specifying the upper bound on the check interval
-// (defaults to DefaultWaitCheckInterval).
-func Wait(t Tester, timeout time.Duration, interval ...time.Duration) Timesert {
+// (defaults to DefaultWaitCheckInterval). The returned Timesert may be given an Observer.
+func Wait(t Tester, timeout time.Duration, interval ...time.Duration) ObservableTimesert {
 	checkInterval := DefaultWaitCheckInterval
 	switch {
 	case len(interval) > 1:
@@ -145,6 +164,13 @@ func Not(p Predicate) Predicate {
 // an error.
 type Assertion func(t Tester)
 
+// Observe registers an observer that will be invoked after each poll, returning the Timesert for chaining. Only one
+// observer may be registered; a subsequent call replaces the previous observer.
+func (ts *timesert) Observe(o Observer) Timesert {
+	ts.observer = o
+	return ts
+}
+
 // Waits until the given predicate is met, up to the timeout configured in the Timesert. Returns
 // the final response of the predicate (true if satisfied).
 func (ts *timesert) Until(p Predicate) bool {
@@ -175,10 +201,15 @@ func (ts *timesert) untilAsserted(a Assertion) bool {
 	var timeoutTimer *time.Timer
 
 	c := NewTestCapture()
+	start := time.Now()
 
-	for {
+	for attempt := 1; ; attempt++ {
 		a(c)
-		if c.Length() == 0 {
+		passed := c.Length() == 0
+		if ts.observer != nil {
+			ts.observer(attempt, time.Since(start), passed)
+		}
+		if passed {
 			return true
 		}
 
@@ -193,7 +224,7 @@ func (ts *timesert) untilAsserted(a Assertion) bool {
 		case <-timeoutTimer.C:
 			for _, cap := range c.Captures() {
 				captured := cap.Captured()
-				ts.t.Errorf("Assertion not satisfied within %v: %s%s", ts.timeout, *captured, PrintStack(3))
+				ts.t.Errorf("Assertion not satisfied within %v: %s%s", ts.timeout, *captured, PrintStack(3+StackOffset))
 			}
 			return false
 		case <-intervalTicker.C:
@@ -203,4 +234,27 @@ func (ts *timesert) untilAsserted(a Assertion) bool {
 	}
 }
 
+// Retry repeatedly invokes fn until it returns a nil error or the timeout elapses, returning the value produced by
+// the last invocation and a flag indicating success. If fn does not succeed within the timeout, the last error
+// is reported to the Tester. The optional interval argument places an upper bound on the check interval (defaults
+// to DefaultWaitCheckInterval).
+//
+// This is a convenience for the common pattern of polling until a value becomes available, then using it:
+//
+//	value, ok := check.Retry(t, time.Second, func() (interface{}, error) { return lookup("key") })
+//
+// Retry is not generic, as this module targets Go 1.14 and type parameters require Go 1.18. Callers must
+// type-assert the returned value.
+func Retry(t Tester, timeout time.Duration, fn func() (interface{}, error), interval ...time.Duration) (interface{}, bool) {
+	var value interface{}
+	passed := Wait(t, timeout, interval...).UntilAsserted(func(t Tester) {
+		var err error
+		value, err = fn()
+		if err != nil {
+			t.Errorf("Retry failed: %v", err)
+		}
+	})
+	return value, passed
+}
+
 func nop() {}
@@ -263,3 +263,83 @@ func TestWait_multipleAssertionsNotWithinDeadline(t *testing.T) {
 	t.Log(second.CapturedLines())
 	assert.Equal(t, 2, second.NumCapturedLines()) // check stack trace elements
 }
+
+func TestWait_observe(t *testing.T) {
+	c := NewTestCapture()
+
+	type observation struct {
+		attempt int
+		elapsed time.Duration
+		passed  bool
+	}
+	observations := make([]observation, 0)
+	polls := 0
+	passed := Wait(c, 10*time.Second, 1*time.Microsecond).
+		Observe(func(attempt int, elapsed time.Duration, passed bool) {
+			observations = append(observations, observation{attempt, elapsed, passed})
+		}).
+		Until(func() bool {
+			polls++
+			return polls == 3
+		})
+	assert.True(t, passed)
+	c.First().AssertNil(t)
+
+	if assert.Len(t, observations, polls) {
+		for i, o := range observations {
+			assert.Equal(t, i+1, o.attempt)
+			assert.Equal(t, i == polls-1, o.passed)
+			if i > 0 {
+				assert.GreaterOrEqual(t, int64(o.elapsed), int64(observations[i-1].elapsed))
+			}
+		}
+	}
+}
+
+func TestWait_observeNotWithinDeadline(t *testing.T) {
+	c := NewTestCapture()
+
+	attempts := 0
+	var lastPassed *bool
+	passed := Wait(c, 1*time.Millisecond, 1*time.Microsecond).
+		Observe(func(attempt int, elapsed time.Duration, passed bool) {
+			attempts = attempt
+			lastPassed = &passed
+		}).
+		Until(func() bool {
+			return false
+		})
+	assert.False(t, passed)
+	assert.GreaterOrEqual(t, attempts, 1)
+	if assert.NotNil(t, lastPassed) {
+		assert.False(t, *lastPassed)
+	}
+}
+
+func TestRetry_eventualSuccess(t *testing.T) {
+	c := NewTestCapture()
+
+	attempts := 0
+	value, ok := Retry(c, 10*time.Second, func() (interface{}, error) {
+		attempts++
+		if attempts < 3 {
+			return nil, ErrSimulated
+		}
+		return "ready", nil
+	}, 1*time.Microsecond)
+	assert.True(t, ok)
+	assert.Equal(t, "ready", value)
+	assert.Equal(t, 3, attempts)
+	c.First().AssertNil(t)
+}
+
+func TestRetry_timeout(t *testing.T) {
+	c := NewTestCapture()
+
+	value, ok := Retry(c, 1*time.Millisecond, func() (interface{}, error) {
+		return "partial", ErrSimulated
+	}, 1*time.Microsecond)
+	assert.False(t, ok)
+	assert.Equal(t, "partial", value)
+	c.First().AssertFirstLineContains(t, "Assertion not satisfied within 1ms: Retry failed: simulated")
+}
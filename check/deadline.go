@@ -0,0 +1,69 @@
+package check
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// WithinDeadline runs fn in a separate goroutine, asserting that it returns within the given duration. On timeout,
+// the test is failed with the stack trace of the blocked goroutine, which is left running. Returns true if fn
+// returned in time.
+//
+// A panic in fn is re-raised on the calling goroutine.
+func WithinDeadline(t Tester, d time.Duration, fn func()) bool {
+	header := make(chan string, 1)
+	done := make(chan interface{}, 1)
+	go func() {
+		completed := false
+		defer func() {
+			if !completed {
+				done <- recover()
+			}
+		}()
+		header <- goroutineHeader()
+		fn()
+		completed = true
+		done <- nil
+	}()
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case cause := <-done:
+		if cause != nil {
+			panic(cause)
+		}
+		return true
+	case <-timer.C:
+		return assert.Fail(t, fmt.Sprintf("Function did not return within %v; blocked goroutine:\n%s",
+			d, goroutineStack(<-header)))
+	}
+}
+
+// Obtains the header line prefix (e.g. 'goroutine 42 ') identifying the calling goroutine in a stack dump.
+func goroutineHeader() string {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	if i := bytes.IndexByte(buf, '['); i >= 0 {
+		return string(buf[:i])
+	}
+	return string(buf)
+}
+
+// Extracts the stack trace of the goroutine with the given header from a dump of all goroutines, returning the
+// entire dump if the goroutine cannot be found.
+func goroutineStack(header string) string {
+	buf := make([]byte, 1<<20)
+	dump := string(buf[:runtime.Stack(buf, true)])
+	for _, stack := range strings.Split(dump, "\n\n") {
+		if strings.HasPrefix(stack, header) {
+			return stack
+		}
+	}
+	return dump
+}
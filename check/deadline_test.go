@@ -0,0 +1,49 @@
+package check
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithinDeadline_returns(t *testing.T) {
+	c := NewTestCapture()
+	ran := false
+	assert.True(t, WithinDeadline(c, 10*time.Second, func() {
+		ran = true
+	}))
+	c.First().AssertNil(t)
+	assert.True(t, ran)
+}
+
+func blockUntilReleased(release chan struct{}) {
+	<-release
+}
+
+func TestWithinDeadline_blocks(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	c := NewTestCapture()
+	assert.False(t, WithinDeadline(c, time.Millisecond, func() {
+		blockUntilReleased(release)
+	}))
+	assert.Equal(t, 1, c.Length())
+	c.First().AssertContains(t, "Function did not return within 1ms")
+	c.First().AssertContains(t, "blockUntilReleased")
+}
+
+func TestWithinDeadline_panic(t *testing.T) {
+	c := NewTestCapture()
+	assert.PanicsWithValue(t, "boom", func() {
+		WithinDeadline(c, 10*time.Second, func() {
+			panic("boom")
+		})
+	})
+	c.First().AssertNil(t)
+}
+
+func TestGoroutineStack_notFound(t *testing.T) {
+	assert.Contains(t, goroutineStack("goroutine -1 "), "TestGoroutineStack_notFound")
+}
@@ -0,0 +1,45 @@
+package check
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// UpdateGoldenEnv is the environment variable that, when set to a non-empty value, causes Golden to regenerate
+// its golden files rather than compare against them; for example, 'UPDATE_GOLDEN=1 go test ./...'.
+const UpdateGoldenEnv = "UPDATE_GOLDEN"
+
+// Golden compares the actual output with the contents of the golden file at the given path, failing with a diff
+// if the two differ, or if the golden file cannot be read.
+//
+// If the UpdateGoldenEnv environment variable is set, the golden file (and any missing parent directories) is
+// instead (re)written with the actual output, and no comparison is made. The regenerated file should be reviewed
+// and checked in alongside the change that caused it.
+func Golden(t Tester, path string, actual []byte) {
+	if os.Getenv(UpdateGoldenEnv) != "" {
+		if err := writeGolden(path, actual); err != nil {
+			assert.Fail(t, fmt.Sprintf("Cannot update golden file '%s': %v", path, err))
+		}
+		return
+	}
+
+	expected, err := ioutil.ReadFile(path)
+	if err != nil {
+		assert.Fail(t, fmt.Sprintf("Cannot read golden file '%s' (set %s to create it): %v",
+			path, UpdateGoldenEnv, err))
+		return
+	}
+	assert.Equal(t, string(expected), string(actual),
+		fmt.Sprintf("Output differs from golden file '%s' (set %s to update it)", path, UpdateGoldenEnv))
+}
+
+func writeGolden(path string, contents []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, contents, 0644)
+}
@@ -0,0 +1,88 @@
+package check
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func tempGolden(t *testing.T, contents string) (string, func()) {
+	dir, err := ioutil.TempDir("", "golden")
+	assert.Nil(t, err)
+	path := filepath.Join(dir, "out.golden")
+	assert.Nil(t, ioutil.WriteFile(path, []byte(contents), 0644))
+	return path, func() { os.RemoveAll(dir) }
+}
+
+func setUpdateGolden(value string) func() {
+	original, wasSet := os.LookupEnv(UpdateGoldenEnv)
+	os.Setenv(UpdateGoldenEnv, value)
+	return func() {
+		if wasSet {
+			os.Setenv(UpdateGoldenEnv, original)
+		} else {
+			os.Unsetenv(UpdateGoldenEnv)
+		}
+	}
+}
+
+func TestGolden_match(t *testing.T) {
+	defer setUpdateGolden("")()
+	path, cleanup := tempGolden(t, "alpha\nbravo\n")
+	defer cleanup()
+
+	c := NewTestCapture()
+	Golden(c, path, []byte("alpha\nbravo\n"))
+	c.First().AssertNil(t)
+}
+
+func TestGolden_mismatch(t *testing.T) {
+	defer setUpdateGolden("")()
+	path, cleanup := tempGolden(t, "alpha\nbravo\n")
+	defer cleanup()
+
+	c := NewTestCapture()
+	Golden(c, path, []byte("alpha\ncharlie\n"))
+	c.First().AssertContains(t, "Output differs from golden file")
+	c.First().AssertContains(t, "-bravo")
+	c.First().AssertContains(t, "+charlie")
+
+	contents, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "alpha\nbravo\n", string(contents))
+}
+
+func TestGolden_missingFile(t *testing.T) {
+	defer setUpdateGolden("")()
+	path, cleanup := tempGolden(t, "")
+	defer cleanup()
+
+	c := NewTestCapture()
+	Golden(c, path+".missing", []byte("alpha"))
+	c.First().AssertContains(t, "Cannot read golden file")
+	c.First().AssertContains(t, UpdateGoldenEnv)
+}
+
+func TestGolden_update(t *testing.T) {
+	defer setUpdateGolden("1")()
+	path, cleanup := tempGolden(t, "alpha\nbravo\n")
+	defer cleanup()
+
+	c := NewTestCapture()
+	Golden(c, path, []byte("alpha\ncharlie\n"))
+	c.First().AssertNil(t)
+	contents, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "alpha\ncharlie\n", string(contents))
+
+	// Missing parent directories are created.
+	nested := filepath.Join(filepath.Dir(path), "nested", "out.golden")
+	Golden(c, nested, []byte("delta"))
+	c.First().AssertNil(t)
+	contents, err = ioutil.ReadFile(nested)
+	assert.Nil(t, err)
+	assert.Equal(t, "delta", string(contents))
+}
@@ -0,0 +1,46 @@
+package check
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Hammer invokes fn concurrently from the given number of goroutines, each performing the given number of
+// iterations, and waits for all invocations to complete. The goroutine and iteration indexes (both starting at 0)
+// are passed to fn. It is intended for exercising the thread-safety of a component, and is best run under the
+// race detector ('go test -race').
+//
+// A panic in fn is reported as a test failure, identifying the goroutine and iteration that panicked; the
+// panicking goroutine abandons its remaining iterations, while the others run to completion. Returns true if no
+// goroutine panicked.
+func Hammer(t Tester, goroutines int, iterations int, fn func(goroutine, iter int)) bool {
+	var lock sync.Mutex
+	failures := make([]string, 0)
+
+	wg := sync.WaitGroup{}
+	wg.Add(goroutines)
+	for g := 0; g < goroutines; g++ {
+		go func(g int) {
+			defer wg.Done()
+			iter := 0
+			defer func() {
+				if cause := recover(); cause != nil {
+					lock.Lock()
+					defer lock.Unlock()
+					failures = append(failures, fmt.Sprintf("Goroutine %d panicked at iteration %d: %v", g, iter, cause))
+				}
+			}()
+			for ; iter < iterations; iter++ {
+				fn(g, iter)
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	for _, failure := range failures {
+		assert.Fail(t, failure)
+	}
+	return len(failures) == 0
+}
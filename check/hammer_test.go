@@ -0,0 +1,43 @@
+package check
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHammer(t *testing.T) {
+	var count int64
+	seen := make([][]bool, 4)
+	for g := range seen {
+		seen[g] = make([]bool, 100)
+	}
+
+	c := NewTestCapture()
+	assert.True(t, Hammer(c, 4, 100, func(g, i int) {
+		atomic.AddInt64(&count, 1)
+		seen[g][i] = true
+	}))
+	c.First().AssertNil(t)
+	assert.Equal(t, int64(400), count)
+	for g := range seen {
+		for i := range seen[g] {
+			assert.True(t, seen[g][i], "goroutine %d, iteration %d", g, i)
+		}
+	}
+}
+
+func TestHammer_panic(t *testing.T) {
+	var count int64
+	c := NewTestCapture()
+	assert.False(t, Hammer(c, 4, 10, func(g, i int) {
+		if g == 2 && i == 5 {
+			panic("boom")
+		}
+		atomic.AddInt64(&count, 1)
+	}))
+	assert.Equal(t, 1, c.Length())
+	c.First().AssertContains(t, "Goroutine 2 panicked at iteration 5: boom")
+	assert.Equal(t, int64(35), count)
+}
@@ -23,7 +23,7 @@ func Appendf(format string, args ...interface{}) Mutation {
 // AddStack adds a stack trace to the end of the capture.
 func AddStack() Mutation {
 	return func(original string) string {
-		return fmt.Sprint(original, PrintStack(2))
+		return fmt.Sprint(original, PrintStack(2+StackOffset))
 	}
 }
 
@@ -44,8 +44,12 @@ type interceptor struct {
 // This is useful when you need to modify the output of a Tester, for instance, to enrich it
 // with additional information that is not available to the assertion framework
 // at the point where an assertion fails.
+//
+// Interceptors may be stacked by intercepting an existing Interceptor, in which case the outermost mutation is
+// applied first, and its output is fed to the next innermost interceptor, and so on, down to the original Tester.
 type Interceptor interface {
 	Tester
+	Unwrap() Tester
 }
 
 // InterceptorStub is an element in a fluid chain.
@@ -69,3 +73,9 @@ func (i *interceptor) Errorf(format string, args ...interface{}) {
 	mutated := i.m(original)
 	i.t.Errorf("%s", mutated)
 }
+
+// Unwrap returns the Tester that was intercepted, allowing for errors to be reported without this interceptor's
+// mutation. Where interceptors are stacked, only the outermost layer is removed.
+func (i *interceptor) Unwrap() Tester {
+	return i.t
+}
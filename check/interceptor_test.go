@@ -1,6 +1,10 @@
 package check
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
 
 func TestAppendf(t *testing.T) {
 	c := NewTestCapture()
@@ -29,3 +33,30 @@ func TestAddStack(t *testing.T) {
 	c.First().AssertFirstLineEqual(t, "Some error")
 	c.First().AssertContains(t, "interceptor_test.go")
 }
+
+func TestUnwrap(t *testing.T) {
+	c := NewTestCapture()
+
+	i := Intercept(c).Mutate(Append("Mutated"))
+	assert.Equal(t, c, i.Unwrap())
+
+	i.Unwrap().Errorf("Some error")
+	c.First().AssertFirstLineEqual(t, "Some error")
+}
+
+func TestStacked(t *testing.T) {
+	c := NewTestCapture()
+
+	inner := Intercept(c).Mutate(Append("Inner"))
+	outer := Intercept(inner).Mutate(Append("Outer"))
+	outer.Errorf("Some error")
+	c.First().AssertFirstLineEqual(t, "Some error Outer Inner")
+	c.Reset()
+
+	outer.Unwrap().Errorf("Some error")
+	c.First().AssertFirstLineEqual(t, "Some error Inner")
+	c.Reset()
+
+	outer.Unwrap().(Interceptor).Unwrap().Errorf("Some error")
+	c.First().AssertFirstLineEqual(t, "Some error")
+}
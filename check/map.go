@@ -0,0 +1,46 @@
+package check
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// MapEqual asserts that two maps of scores (such as those produced by concurrent.Scoreboard.View) are equal,
+// returning true if this is the case. Otherwise, the test is failed with a report listing, in key order, each key
+// whose value differs, each expected key that is missing, and each actual key that was not expected.
+//
+// Keys that are present with a zero value are distinguished from missing keys.
+func MapEqual(t Tester, expected, actual map[string]int64) bool {
+	keys := make([]string, 0, len(expected)+len(actual))
+	for k := range expected {
+		keys = append(keys, k)
+	}
+	for k := range actual {
+		if _, ok := expected[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	diffs := make([]string, 0)
+	for _, k := range keys {
+		expectedValue, inExpected := expected[k]
+		actualValue, inActual := actual[k]
+		switch {
+		case !inActual:
+			diffs = append(diffs, fmt.Sprintf("missing key '%s': expected %d", k, expectedValue))
+		case !inExpected:
+			diffs = append(diffs, fmt.Sprintf("extra key '%s': got %d", k, actualValue))
+		case expectedValue != actualValue:
+			diffs = append(diffs, fmt.Sprintf("key '%s': expected %d, got %d", k, expectedValue, actualValue))
+		}
+	}
+
+	if len(diffs) > 0 {
+		return assert.Fail(t, fmt.Sprintf("Maps differ in %d key(s):\n%s", len(diffs), strings.Join(diffs, "\n")))
+	}
+	return true
+}
@@ -0,0 +1,29 @@
+package check
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapEqual_equal(t *testing.T) {
+	c := NewTestCapture()
+	assert.True(t, MapEqual(c, map[string]int64{"a": 1, "b": 2}, map[string]int64{"b": 2, "a": 1}))
+	assert.True(t, MapEqual(c, map[string]int64{}, nil))
+	c.First().AssertNil(t)
+}
+
+func TestMapEqual_differentValue(t *testing.T) {
+	c := NewTestCapture()
+	assert.False(t, MapEqual(c, map[string]int64{"a": 1, "b": 2}, map[string]int64{"a": 1, "b": 3}))
+	c.First().AssertContains(t, "Maps differ in 1 key(s):")
+	c.First().AssertContains(t, "key 'b': expected 2, got 3")
+}
+
+func TestMapEqual_missingAndExtra(t *testing.T) {
+	c := NewTestCapture()
+	assert.False(t, MapEqual(c, map[string]int64{"a": 1, "b": 0}, map[string]int64{"a": 1, "c": 0}))
+	c.First().AssertContains(t, "Maps differ in 2 key(s):")
+	c.First().AssertContains(t, "missing key 'b': expected 0")
+	c.First().AssertContains(t, "extra key 'c': got 0")
+}
@@ -0,0 +1,25 @@
+package check
+
+import (
+	"fmt"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Monotonic asserts that the given values are in increasing order, returning true if this is the case. If strictly
+// is set, each value must be greater than its predecessor; otherwise, adjacent values may be equal. On failure, the
+// first violating index is reported. It is useful for verifying sequence numbers, counters and timestamps.
+func Monotonic(t Tester, values []int64, strictly bool) bool {
+	for i := 1; i < len(values); i++ {
+		prev, curr := values[i-1], values[i]
+		switch {
+		case strictly && curr <= prev:
+			return assert.Fail(t, fmt.Sprintf("Expected strictly increasing values; values[%d]=%d is not greater than values[%d]=%d",
+				i, curr, i-1, prev))
+		case !strictly && curr < prev:
+			return assert.Fail(t, fmt.Sprintf("Expected increasing values; values[%d]=%d is less than values[%d]=%d",
+				i, curr, i-1, prev))
+		}
+	}
+	return true
+}
@@ -0,0 +1,39 @@
+package check
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMonotonic_increasing(t *testing.T) {
+	c := NewTestCapture()
+	assert.True(t, Monotonic(c, []int64{-1, 0, 3, 7}, true))
+	assert.True(t, Monotonic(c, []int64{-1, 0, 3, 7}, false))
+	assert.True(t, Monotonic(c, []int64{}, true))
+	assert.True(t, Monotonic(c, nil, true))
+	assert.True(t, Monotonic(c, []int64{5}, true))
+	c.First().AssertNil(t)
+}
+
+func TestMonotonic_equalAdjacent(t *testing.T) {
+	c := NewTestCapture()
+	assert.True(t, Monotonic(c, []int64{1, 2, 2, 3}, false))
+	c.First().AssertNil(t)
+
+	assert.False(t, Monotonic(c, []int64{1, 2, 2, 3}, true))
+	assert.Equal(t, 1, c.Length())
+	c.First().AssertContains(t, "Expected strictly increasing values; values[2]=2 is not greater than values[1]=2")
+}
+
+func TestMonotonic_decreasing(t *testing.T) {
+	c := NewTestCapture()
+	assert.False(t, Monotonic(c, []int64{1, 3, 2, 1}, false))
+	assert.Equal(t, 1, c.Length())
+	c.First().AssertContains(t, "Expected increasing values; values[2]=2 is less than values[1]=3")
+
+	c.Reset()
+	assert.False(t, Monotonic(c, []int64{1, 3, 2, 1}, true))
+	assert.Equal(t, 1, c.Length())
+	c.First().AssertContains(t, "values[2]=2 is not greater than values[1]=3")
+}
@@ -0,0 +1,72 @@
+package check
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Spy records the invocations of the functions that it produces, for verifying that a callback (such as a trigger
+// or a hook) was called the expected number of times, and with the expected arguments. All functions produced by the
+// same Spy contribute to a common record. This implementation is thread-safe.
+type Spy interface {
+	Func() func()
+	FuncArgs() func(args ...interface{})
+	Calls() int
+	Args() [][]interface{}
+	AssertCalled(t Tester, n int) bool
+}
+
+type spy struct {
+	lock  sync.Mutex
+	calls [][]interface{}
+}
+
+// NewSpy creates a new Spy that has not recorded any calls.
+func NewSpy() Spy {
+	return &spy{calls: [][]interface{}{}}
+}
+
+// Func returns a no-arg function that records each of its invocations.
+func (s *spy) Func() func() {
+	return func() {
+		s.record(nil)
+	}
+}
+
+// FuncArgs returns a variadic function that records each of its invocations, along with the arguments supplied.
+func (s *spy) FuncArgs() func(args ...interface{}) {
+	return func(args ...interface{}) {
+		s.record(args)
+	}
+}
+
+func (s *spy) record(args []interface{}) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.calls = append(s.calls, args)
+}
+
+// Calls returns the number of recorded invocations.
+func (s *spy) Calls() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.calls)
+}
+
+// Args returns the arguments of each recorded invocation, in the order of invocation. Invocations of a no-arg
+// function produced by Func are recorded with nil arguments.
+func (s *spy) Args() [][]interface{} {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([][]interface{}{}, s.calls...)
+}
+
+// AssertCalled asserts that exactly n invocations were recorded, returning true if this is the case.
+func (s *spy) AssertCalled(t Tester, n int) bool {
+	if calls := s.Calls(); calls != n {
+		return assert.Fail(t, fmt.Sprintf("Expected %d call(s); got %d", n, calls))
+	}
+	return true
+}
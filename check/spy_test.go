@@ -0,0 +1,51 @@
+package check
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpy_func(t *testing.T) {
+	s := NewSpy()
+	c := NewTestCapture()
+	assert.True(t, s.AssertCalled(c, 0))
+
+	f := s.Func()
+	for i := 0; i < 3; i++ {
+		f()
+	}
+	assert.Equal(t, 3, s.Calls())
+	assert.True(t, s.AssertCalled(c, 3))
+	c.First().AssertNil(t)
+	assert.Equal(t, [][]interface{}{nil, nil, nil}, s.Args())
+
+	assert.False(t, s.AssertCalled(c, 2))
+	assert.Equal(t, 1, c.Length())
+	c.First().AssertContains(t, "Expected 2 call(s); got 3")
+}
+
+func TestSpy_funcArgs(t *testing.T) {
+	s := NewSpy()
+	f := s.FuncArgs()
+	f("a", 1)
+	f()
+	s.Func()()
+
+	assert.Equal(t, 3, s.Calls())
+	args := s.Args()
+	assert.Equal(t, [][]interface{}{{"a", 1}, nil, nil}, args)
+
+	// The returned arguments are a copy.
+	args[0] = nil
+	assert.Equal(t, []interface{}{"a", 1}, s.Args()[0])
+}
+
+func TestSpy_concurrent(t *testing.T) {
+	s := NewSpy()
+	f := s.FuncArgs()
+	Hammer(t, 8, 100, func(g, i int) {
+		f(g, i)
+	})
+	s.AssertCalled(t, 800)
+}
@@ -0,0 +1,41 @@
+package check
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Swap sets the variable that ptr points to to the given value, returning a function that restores the variable's
+// original value. It is intended for temporarily overriding package-level configuration in tests:
+//
+//	defer check.Swap(&scribe.HostFieldKey, "hostname")()
+//
+// The variable may be of any type; ptr must be a non-nil pointer, and value must be assignable to the variable's type
+// (a nil value sets the variable to its zero value). Otherwise, this function panics.
+//
+// Swap is not generic, as this module targets Go 1.14 and type parameters require Go 1.18. Consequently, a
+// mismatched value is caught at run time by the panic above, rather than by the compiler.
+func Swap(ptr interface{}, value interface{}) (restore func()) {
+	ptrValue := reflect.ValueOf(ptr)
+	if ptrValue.Kind() != reflect.Ptr || ptrValue.IsNil() {
+		panic(fmt.Errorf("expected a non-nil pointer; got %T", ptr))
+	}
+
+	target := ptrValue.Elem()
+	var newValue reflect.Value
+	if value == nil {
+		newValue = reflect.Zero(target.Type())
+	} else {
+		newValue = reflect.ValueOf(value)
+		if !newValue.Type().AssignableTo(target.Type()) {
+			panic(fmt.Errorf("cannot assign %T to %s", value, target.Type()))
+		}
+	}
+
+	original := reflect.New(target.Type()).Elem()
+	original.Set(target)
+	target.Set(newValue)
+	return func() {
+		target.Set(original)
+	}
+}
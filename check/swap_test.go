@@ -0,0 +1,73 @@
+package check
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	swappableString = "original"
+	swappableInt    = 42
+	swappableErr    = errors.New("original")
+)
+
+func TestSwapString(t *testing.T) {
+	restore := Swap(&swappableString, "swapped")
+	assert.Equal(t, "swapped", swappableString)
+	restore()
+	assert.Equal(t, "original", swappableString)
+}
+
+func TestSwapInt(t *testing.T) {
+	func() {
+		defer Swap(&swappableInt, 7)()
+		assert.Equal(t, 7, swappableInt)
+	}()
+	assert.Equal(t, 42, swappableInt)
+}
+
+func TestSwapNested(t *testing.T) {
+	restoreOuter := Swap(&swappableInt, 1)
+	restoreInner := Swap(&swappableInt, 2)
+	assert.Equal(t, 2, swappableInt)
+	restoreInner()
+	assert.Equal(t, 1, swappableInt)
+	restoreOuter()
+	assert.Equal(t, 42, swappableInt)
+}
+
+func TestSwapInterface(t *testing.T) {
+	original := swappableErr
+	restore := Swap(&swappableErr, ErrSimulated)
+	assert.Equal(t, ErrSimulated, swappableErr)
+	restore()
+	assert.Equal(t, original, swappableErr)
+
+	restore = Swap(&swappableErr, nil)
+	assert.Nil(t, swappableErr)
+	restore()
+	assert.Equal(t, original, swappableErr)
+}
+
+func TestSwapInvalid(t *testing.T) {
+	ThatPanicsAsExpected(t, ErrorWithValue("expected a non-nil pointer; got string"), func() {
+		Swap(swappableString, "swapped")
+	})
+	ThatPanicsAsExpected(t, ErrorWithValue("expected a non-nil pointer; got *int"), func() {
+		Swap((*int)(nil), 1)
+	})
+}
+
+func TestSwapUnassignable(t *testing.T) {
+	ThatPanicsAsExpected(t, ErrorWithValue("cannot assign string to int"), func() {
+		Swap(&swappableInt, "one")
+	})
+	assert.Equal(t, 42, swappableInt)
+
+	ThatPanicsAsExpected(t, ErrorWithValue("cannot assign int to error"), func() {
+		Swap(&swappableErr, 1)
+	})
+	assert.Equal(t, "original", swappableErr.Error())
+}
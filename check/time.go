@@ -0,0 +1,35 @@
+package check
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TimeWithinDelta asserts that the actual time differs from the expected time by no more than the given delta, in
+// either direction, returning true if this is the case.
+func TimeWithinDelta(t Tester, expected, actual time.Time, delta time.Duration) bool {
+	if diff := abs(actual.Sub(expected)); diff > delta {
+		return assert.Fail(t, fmt.Sprintf("Expected time %v to be within %v of %v; differs by %v",
+			actual, delta, expected, diff))
+	}
+	return true
+}
+
+// DurationWithinDelta asserts that the actual duration differs from the expected duration by no more than the given
+// delta, in either direction, returning true if this is the case.
+func DurationWithinDelta(t Tester, expected, actual time.Duration, delta time.Duration) bool {
+	if diff := abs(actual - expected); diff > delta {
+		return assert.Fail(t, fmt.Sprintf("Expected duration %v to be within %v of %v; differs by %v",
+			actual, delta, expected, diff))
+	}
+	return true
+}
+
+func abs(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
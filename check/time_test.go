@@ -0,0 +1,32 @@
+package check
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeWithinDelta(t *testing.T) {
+	base := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewTestCapture()
+	assert.True(t, TimeWithinDelta(c, base, base, 0))
+	assert.True(t, TimeWithinDelta(c, base, base.Add(time.Second), time.Second))
+	assert.True(t, TimeWithinDelta(c, base, base.Add(-time.Second), time.Second))
+	c.First().AssertNil(t)
+
+	assert.False(t, TimeWithinDelta(c, base, base.Add(-2*time.Second), time.Second))
+	c.First().AssertContains(t, "Expected time 2019-12-31 23:59:58 +0000 UTC to be within 1s of "+
+		"2020-01-01 00:00:00 +0000 UTC; differs by 2s")
+}
+
+func TestDurationWithinDelta(t *testing.T) {
+	c := NewTestCapture()
+	assert.True(t, DurationWithinDelta(c, time.Second, time.Second, 0))
+	assert.True(t, DurationWithinDelta(c, time.Second, 900*time.Millisecond, 100*time.Millisecond))
+	assert.True(t, DurationWithinDelta(c, time.Second, 1100*time.Millisecond, 100*time.Millisecond))
+	c.First().AssertNil(t)
+
+	assert.False(t, DurationWithinDelta(c, time.Second, 1200*time.Millisecond, 100*time.Millisecond))
+	c.First().AssertContains(t, "Expected duration 1.2s to be within 100ms of 1s; differs by 200ms")
+}
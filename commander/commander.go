@@ -9,6 +9,8 @@ package commander
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -57,6 +59,112 @@ func (pm PartsMap) Value(name string, def string) (string, error) {
 	}
 }
 
+// ValueFold is a case-insensitive variant of Value, matching all keys that are equal to the given name under Unicode
+// case-folding; e.g. '-Verbose' and '-verbose' are treated as the same argument. As with Value, if two or more
+// values are present among the matching keys — whether under the same key or under keys that differ only in case —
+// the first value is returned along with an error. Matching keys are considered in lexical order, so that the result
+// is deterministic.
+func (pm PartsMap) ValueFold(name string, def string) (string, error) {
+	return PartsMap{name: pm.valuesFold(name)}.Value(name, def)
+}
+
+// HasFold returns true if the map contains at least one value for a key that is equal to the given name under
+// Unicode case-folding.
+func (pm PartsMap) HasFold(name string) bool {
+	return len(pm.valuesFold(name)) > 0
+}
+
+func (pm PartsMap) valuesFold(name string) []string {
+	keys := make([]string, 0, 1)
+	for k := range pm {
+		if strings.EqualFold(k, name) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	values := make([]string, 0, len(keys))
+	for _, k := range keys {
+		values = append(values, pm[k]...)
+	}
+	return values
+}
+
+// NegationPrefix is prepended to the name of a boolean switch to negate it; e.g. '-no-verbose' negates '-verbose'.
+const NegationPrefix = "no-"
+
+// Parses a boolean value, negating it if the value belongs to a negated switch. If the value cannot be parsed, the
+// default is returned along with an error.
+func parseBool(value string, negated bool, def bool) (bool, error) {
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return def, fmt.Errorf("invalid boolean value '%s'", value)
+	}
+	return b != negated, nil
+}
+
+// BoolValue obtains a boolean value for the given name, recognising both the plain form (e.g. '-verbose') and the
+// negated form (e.g. '-no-verbose'), where the latter explicitly sets the value to false. If the name appears
+// multiple times in either form, the later occurrence wins. If neither form is present, the default value is
+// returned. An error is returned if the value cannot be parsed as a boolean, in which case the default is returned.
+func (parts Parts) BoolValue(name string, def bool) (bool, error) {
+	negatedName := NegationPrefix + name
+	for i := len(parts) - 1; i >= 0; i-- {
+		switch parts[i].Name {
+		case name:
+			return parseBool(parts[i].Value, false, def)
+		case negatedName:
+			return parseBool(parts[i].Value, true, def)
+		}
+	}
+	return def, nil
+}
+
+// BoolValue obtains a boolean value for the given name, recognising both the plain form (e.g. '-verbose') and the
+// negated form (e.g. '-no-verbose'), where the latter explicitly sets the value to false. If the name appears
+// multiple times in the same form, the later occurrence wins. If neither form is present, the default value is
+// returned.
+//
+// Because a PartsMap does not retain the relative order of differently-named parts, the default value is returned
+// along with an error if both forms are present; use Parts.BoolValue to have the later occurrence win. An error is
+// also returned if the value cannot be parsed as a boolean.
+func (pm PartsMap) BoolValue(name string, def bool) (bool, error) {
+	values, negatedValues := pm[name], pm[NegationPrefix+name]
+	switch {
+	case len(values) > 0 && len(negatedValues) > 0:
+		return def, fmt.Errorf("conflicting arguments: both '%s' and '%s%s' present", name, NegationPrefix, name)
+	case len(values) > 0:
+		return parseBool(values[len(values)-1], false, def)
+	case len(negatedValues) > 0:
+		return parseBool(negatedValues[len(negatedValues)-1], true, def)
+	default:
+		return def, nil
+	}
+}
+
+// FreeForms returns the values of the free-form parts, in the order in which they appear on the command line.
+// Together with Named, this allows callers to reconstruct positional meaning that is lost by Mappify.
+func (parts Parts) FreeForms() []string {
+	freeForms := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p.IsFreeForm() {
+			freeForms = append(freeForms, p.Value)
+		}
+	}
+	return freeForms
+}
+
+// Named returns the named parts (flags and switches), in the order in which they appear on the command line.
+func (parts Parts) Named() []Part {
+	named := make([]Part, 0, len(parts))
+	for _, p := range parts {
+		if !p.IsFreeForm() {
+			named = append(named, p)
+		}
+	}
+	return named
+}
+
 // Mappify transforms the parsed Parts into a PartsMap for convenient retrieval of argument values.
 func (parts Parts) Mappify() PartsMap {
 	partsMap := PartsMap{}
@@ -72,9 +180,53 @@ func (parts Parts) Mappify() PartsMap {
 	return partsMap
 }
 
+// Option configures the behaviour of the parser. Options are supplied to Parse.
+type Option func(o *options)
+
+type options struct {
+	assignments bool
+	multiValued map[string]bool
+}
+
+// ParseAssignments is an option that treats an undashed token containing an equals sign (e.g. 'key=value', in the
+// style of 'make VAR=x') as a named part, rather than a free-form value. A token with a leading equals sign
+// (i.e. lacking a name) is still treated as free-form.
+func ParseAssignments() Option {
+	return func(o *options) {
+		o.assignments = true
+	}
+}
+
+// MultiValued is an option that causes each of the named flags, when given in the '-name value' form, to consume all
+// subsequent undashed tokens as repeated values for that name, up to the next flag or switch. For example, with
+// MultiValued("tags"), the arguments '-tags a b c -verbose' yield three 'tags' parts (with the values 'a', 'b' and
+// 'c'), followed by the 'verbose' switch. The '-name=value' form continues to yield a single value. The option may
+// be supplied multiple times; the names accumulate.
+//
+// Note: a multi-valued flag is greedy, making it indistinguishable from a flag that is followed by free-form
+// (trailing) arguments. In '-tags a b file.txt', 'file.txt' is parsed as a fourth tag. Callers should place
+// multi-valued flags before any free-form arguments, or terminate them with another flag.
+func MultiValued(names ...string) Option {
+	return func(o *options) {
+		if o.multiValued == nil {
+			o.multiValued = map[string]bool{}
+		}
+		for _, name := range names {
+			o.multiValued[name] = true
+		}
+	}
+}
+
 // Parse processes the given cmdArgs into a Parts slice. No error is returned as parsing is schemaless; the parser
 // extracts all flags, switches and free-form values that may be present.
-func Parse(cmdArgs []string) Parts {
+//
+// The default parsing behaviour may be altered by supplying one or more options.
+func Parse(cmdArgs []string, opts ...Option) Parts {
+	o := options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	len := len(cmdArgs)
 	args := make([]Part, 0, len/2)
 	for i := 0; i < len; i++ {
@@ -91,13 +243,23 @@ func Parse(cmdArgs []string) Parts {
 					args = append(args, Part{currArg[currDashes:], "true"})
 				} else {
 					// In the form '-arg value'
-					args = append(args, Part{currArg[currDashes:], peekArg})
+					name := currArg[currDashes:]
+					args = append(args, Part{name, peekArg})
 					i++
+					if o.multiValued[name] {
+						// In the form '-arg value value ...'
+						for ; i < len-1 && dashes(cmdArgs[i+1]) == 0; i++ {
+							args = append(args, Part{name, cmdArgs[i+1]})
+						}
+					}
 				}
 			} else {
 				// In the form '-arg'
 				args = append(args, Part{currArg[currDashes:], "true"})
 			}
+		} else if split := strings.IndexByte(currArg, '='); o.assignments && split > 0 {
+			// In the form 'arg=value'
+			args = append(args, Part{currArg[:split], currArg[split+1:]})
 		} else {
 			// Standalone token
 			args = append(args, Part{"", currArg})
@@ -106,6 +268,52 @@ func Parse(cmdArgs []string) Parts {
 	return args
 }
 
+// ResponseFilePrefix marks a command-line token as a reference to a response file; e.g. '@args.txt'.
+const ResponseFilePrefix = "@"
+
+// ExpandResponseFiles replaces each token of the form '@path' in cmdArgs with the whitespace-separated contents
+// of the file at path, as read by the given readFile function (typically ioutil.ReadFile). This allows long command
+// lines to be stored in files, and should be applied before Parse. A lone '@' is not treated as a reference.
+//
+// Response files may themselves reference other response files, which are expanded recursively. An error is
+// returned if a file cannot be read, or if a file references itself, whether directly or indirectly.
+//
+// Note: the contents are split on whitespace without regard to quoting; a value containing spaces cannot be
+// expressed in a response file.
+func ExpandResponseFiles(cmdArgs []string, readFile func(path string) ([]byte, error)) ([]string, error) {
+	return expandResponseFiles(cmdArgs, readFile, nil)
+}
+
+func expandResponseFiles(cmdArgs []string, readFile func(path string) ([]byte, error), chain []string) ([]string, error) {
+	expanded := make([]string, 0, len(cmdArgs))
+	for _, arg := range cmdArgs {
+		if !strings.HasPrefix(arg, ResponseFilePrefix) || len(arg) == len(ResponseFilePrefix) {
+			expanded = append(expanded, arg)
+			continue
+		}
+
+		path := arg[len(ResponseFilePrefix):]
+		for _, ancestor := range chain {
+			if ancestor == path {
+				return nil, fmt.Errorf("cyclic response file reference: %s -> %s", strings.Join(chain, " -> "), path)
+			}
+		}
+
+		contents, err := readFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read response file '%s': %w", path, err)
+		}
+		// Capping the capacity forces a copy, so that sibling references do not share the chain's backing array.
+		nestedChain := append(chain[:len(chain):len(chain)], path)
+		nested, err := expandResponseFiles(strings.Fields(string(contents)), readFile, nestedChain)
+		if err != nil {
+			return nil, err
+		}
+		expanded = append(expanded, nested...)
+	}
+	return expanded, nil
+}
+
 // Returns the number of leading dashes contained in a given argument, up to a maximum of two. If the argument
 // has three or more leading dashes, it is reported as containing no dashes, thereby being treated as something
 // other than a switch or a flag. If the argument is just a dash (or double-dash) on its own, it is also reported
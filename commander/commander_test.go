@@ -104,3 +104,232 @@ func TestValue(t *testing.T) {
 	assert.Equal(t, "go", value)
 	assert.NotNil(t, err)
 }
+
+func TestParse_assignments(t *testing.T) {
+	cases := []struct {
+		cmdArgs []string
+		expect  Parts
+	}{
+		{cmdArgs: []string{"foo=bar"},
+			expect: Parts{Part{"foo", "bar"}}},
+		{cmdArgs: []string{"--foo=bar"},
+			expect: Parts{Part{"foo", "bar"}}},
+		{cmdArgs: []string{"foo"},
+			expect: Parts{Part{"", "foo"}}},
+		{cmdArgs: []string{"foo="},
+			expect: Parts{Part{"foo", ""}}},
+		{cmdArgs: []string{"=bar"},
+			expect: Parts{Part{"", "=bar"}}},
+		{cmdArgs: []string{"make", "VAR=x", "-run", "a=b", "trail"},
+			expect: Parts{Part{"", "make"}, Part{"VAR", "x"}, Part{"run", "a=b"}, Part{"", "trail"}}},
+	}
+
+	for _, c := range cases {
+		parsed := Parse(c.cmdArgs, ParseAssignments())
+		assert.Equal(t, c.expect, parsed)
+	}
+}
+
+func TestParse_assignmentsByDefaultFreeForm(t *testing.T) {
+	assert.Equal(t, Parts{Part{"", "foo=bar"}, Part{"foo", "bar"}, Part{"", "foo"}},
+		Parse([]string{"foo=bar", "--foo=bar", "foo"}))
+}
+
+func TestParse_multiValued(t *testing.T) {
+	cases := []struct {
+		cmdArgs []string
+		expect  Parts
+	}{
+		{
+			cmdArgs: []string{"-tags", "a", "b", "c", "-verbose"},
+			expect:  Parts{Part{"tags", "a"}, Part{"tags", "b"}, Part{"tags", "c"}, Part{"verbose", "true"}},
+		},
+		{
+			cmdArgs: []string{"--tags", "a", "b"},
+			expect:  Parts{Part{"tags", "a"}, Part{"tags", "b"}},
+		},
+		{
+			cmdArgs: []string{"-tags=a", "b"},
+			expect:  Parts{Part{"tags", "a"}, Part{"", "b"}},
+		},
+		{
+			cmdArgs: []string{"-tags", "-verbose"},
+			expect:  Parts{Part{"tags", "true"}, Part{"verbose", "true"}},
+		},
+		{
+			cmdArgs: []string{"-other", "a", "b", "-tags", "c", "d", "-labels", "e", "f"},
+			expect: Parts{Part{"other", "a"}, Part{"", "b"}, Part{"tags", "c"}, Part{"tags", "d"},
+				Part{"labels", "e"}, Part{"labels", "f"}},
+		},
+	}
+
+	for _, c := range cases {
+		parsed := Parse(c.cmdArgs, MultiValued("tags"), MultiValued("labels"))
+		assert.Equal(t, c.expect, parsed, "case %v", c)
+	}
+}
+
+func TestParse_multiValuedMappify(t *testing.T) {
+	pm := Parse([]string{"-tags", "a", "b", "c", "-verbose"}, MultiValued("tags")).Mappify()
+	assert.Equal(t, []string{"a", "b", "c"}, pm["tags"])
+	assert.Equal(t, []string{"true"}, pm["verbose"])
+}
+
+func TestBoolValue(t *testing.T) {
+	cases := []struct {
+		cmdArgs []string
+		def     bool
+		expect  bool
+	}{
+		{cmdArgs: []string{"-verbose"}, def: false, expect: true},
+		{cmdArgs: []string{"-no-verbose"}, def: true, expect: false},
+		{cmdArgs: []string{"-verbose", "-no-verbose"}, def: true, expect: false},
+		{cmdArgs: []string{"-no-verbose", "-verbose"}, def: false, expect: true},
+		{cmdArgs: []string{}, def: false, expect: false},
+		{cmdArgs: []string{}, def: true, expect: true},
+		{cmdArgs: []string{"-verbose=false"}, def: true, expect: false},
+		{cmdArgs: []string{"-no-verbose=false"}, def: false, expect: true},
+		{cmdArgs: []string{"-verbose", "-verbose=false"}, def: true, expect: false},
+		{cmdArgs: []string{"-verbosity", "-no-verbosity"}, def: true, expect: true},
+	}
+
+	for _, c := range cases {
+		value, err := Parse(c.cmdArgs).BoolValue("verbose", c.def)
+		assert.Nil(t, err, "for case %v", c)
+		assert.Equal(t, c.expect, value, "for case %v", c)
+	}
+}
+
+func TestBoolValue_invalid(t *testing.T) {
+	value, err := Parse([]string{"-verbose=maybe"}).BoolValue("verbose", true)
+	assert.True(t, value)
+	assert.Equal(t, errors.New("invalid boolean value 'maybe'"), err)
+
+	value, err = Parse([]string{"-verbose=maybe"}).Mappify().BoolValue("verbose", true)
+	assert.True(t, value)
+	assert.Equal(t, errors.New("invalid boolean value 'maybe'"), err)
+}
+
+func TestPartsMapBoolValue(t *testing.T) {
+	cases := []struct {
+		cmdArgs []string
+		def     bool
+		expect  bool
+	}{
+		{cmdArgs: []string{"-verbose"}, def: false, expect: true},
+		{cmdArgs: []string{"-no-verbose"}, def: true, expect: false},
+		{cmdArgs: []string{}, def: false, expect: false},
+		{cmdArgs: []string{}, def: true, expect: true},
+		{cmdArgs: []string{"-verbose", "-verbose=false"}, def: true, expect: false},
+	}
+
+	for _, c := range cases {
+		value, err := Parse(c.cmdArgs).Mappify().BoolValue("verbose", c.def)
+		assert.Nil(t, err, "for case %v", c)
+		assert.Equal(t, c.expect, value, "for case %v", c)
+	}
+}
+
+func TestPartsMapBoolValue_bothPresent(t *testing.T) {
+	value, err := Parse([]string{"-verbose", "-no-verbose"}).Mappify().BoolValue("verbose", true)
+	assert.True(t, value)
+	assert.Equal(t, errors.New("conflicting arguments: both 'verbose' and 'no-verbose' present"), err)
+}
+
+func TestFreeFormsAndNamed(t *testing.T) {
+	parts := Parse([]string{"build", "-v=true", "pkg1", "--out", "bin", "pkg2", "-x=1", "pkg3", "-v=false"})
+	assert.Equal(t, []string{"build", "pkg1", "pkg2", "pkg3"}, parts.FreeForms())
+	assert.Equal(t, []Part{
+		{Name: "v", Value: "true"},
+		{Name: "out", Value: "bin"},
+		{Name: "x", Value: "1"},
+		{Name: "v", Value: "false"},
+	}, parts.Named())
+}
+
+func TestFreeFormsAndNamed_empty(t *testing.T) {
+	parts := Parse([]string{})
+	assert.Empty(t, parts.FreeForms())
+	assert.Empty(t, parts.Named())
+}
+
+func fileReader(files map[string]string) func(string) ([]byte, error) {
+	return func(path string) ([]byte, error) {
+		if contents, ok := files[path]; ok {
+			return []byte(contents), nil
+		}
+		return nil, errors.New("file not found")
+	}
+}
+
+func TestExpandResponseFiles_simple(t *testing.T) {
+	read := fileReader(map[string]string{
+		"args.txt": "-tags a b\n  --verbose\tfile.txt\n",
+	})
+	expanded, err := ExpandResponseFiles([]string{"-before", "@args.txt", "after", "@"}, read)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"-before", "-tags", "a", "b", "--verbose", "file.txt", "after", "@"}, expanded)
+}
+
+func TestExpandResponseFiles_nested(t *testing.T) {
+	read := fileReader(map[string]string{
+		"outer.txt": "-a @inner.txt -d @inner.txt",
+		"inner.txt": "-b @leaf.txt",
+		"leaf.txt":  "-c",
+		"empty.txt": "",
+	})
+	expanded, err := ExpandResponseFiles([]string{"@outer.txt", "@empty.txt", "-e"}, read)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"-a", "-b", "-c", "-d", "-b", "-c", "-e"}, expanded)
+}
+
+func TestExpandResponseFiles_cycle(t *testing.T) {
+	read := fileReader(map[string]string{
+		"a.txt": "-x @b.txt",
+		"b.txt": "@a.txt",
+	})
+	expanded, err := ExpandResponseFiles([]string{"@a.txt"}, read)
+	assert.Nil(t, expanded)
+	assert.EqualError(t, err, "cyclic response file reference: a.txt -> b.txt -> a.txt")
+}
+
+func TestExpandResponseFiles_readError(t *testing.T) {
+	expanded, err := ExpandResponseFiles([]string{"@missing.txt"}, fileReader(map[string]string{}))
+	assert.Nil(t, expanded)
+	assert.EqualError(t, err, "cannot read response file 'missing.txt': file not found")
+}
+
+func TestPartsMap_valueFold(t *testing.T) {
+	pm := Parse([]string{"-Verbose", "--LEVEL=info", "-name", "x"}).Mappify()
+
+	value, err := pm.ValueFold("verbose", "false")
+	assert.Nil(t, err)
+	assert.Equal(t, "true", value)
+
+	value, err = pm.ValueFold("level", "warn")
+	assert.Nil(t, err)
+	assert.Equal(t, "info", value)
+
+	value, err = pm.ValueFold("missing", "def")
+	assert.Nil(t, err)
+	assert.Equal(t, "def", value)
+
+	// Value remains case-sensitive.
+	value, err = pm.Value("verbose", "false")
+	assert.Nil(t, err)
+	assert.Equal(t, "false", value)
+}
+
+func TestPartsMap_valueFoldCollision(t *testing.T) {
+	pm := Parse([]string{"-name=b", "-Name=a"}).Mappify()
+	value, err := pm.ValueFold("NAME", "def")
+	assert.Equal(t, "a", value)
+	assert.EqualError(t, err, "too many arguments: expected one or none, got 2")
+}
+
+func TestPartsMap_hasFold(t *testing.T) {
+	pm := Parse([]string{"-Verbose", "file.txt"}).Mappify()
+	assert.True(t, pm.HasFold("verbose"))
+	assert.True(t, pm.HasFold("VERBOSE"))
+	assert.False(t, pm.HasFold("quiet"))
+}
@@ -0,0 +1,49 @@
+package concurrent
+
+import (
+	"context"
+	"time"
+)
+
+// Condition is a general-purpose predicate that is polled by AwaitCtxLogged.
+type Condition func() bool
+
+// DefaultAwaitCheckInterval is the default check interval used by AwaitCtxLogged.
+const DefaultAwaitCheckInterval = 10 * time.Millisecond
+
+// AwaitCtxLogged blocks until the given condition is met or the context is cancelled, returning true if the
+// condition was met. The condition is polled at the check interval, which may be given as an optional argument
+// (defaults to DefaultAwaitCheckInterval).
+//
+// Each poll is logged using the supplied Printf-style function, which is typically a trace-level logger. With Scribe,
+// supplying the trace logger of a captured scene ensures that all poll entries carry the same scene:
+//
+//	logger := s.Capture(scene)
+//	concurrent.AwaitCtxLogged(ctx, cond, logger.T())
+//
+// The condition may close over the same logger to have its own entries logged consistently.
+func AwaitCtxLogged(ctx context.Context, cond Condition, logf func(format string, args ...interface{}),
+	interval ...time.Duration) bool {
+	checkInterval := optional(DefaultAwaitCheckInterval, interval...)
+	start := time.Now()
+	var sleepTicker *time.Ticker
+	for attempt := 1; ; attempt++ {
+		satisfied := cond()
+		logf("Await poll %d: satisfied=%t, elapsed=%v", attempt, satisfied, time.Since(start))
+		if satisfied {
+			return true
+		}
+
+		if sleepTicker == nil {
+			sleepTicker = time.NewTicker(checkInterval)
+			defer sleepTicker.Stop()
+		}
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-sleepTicker.C:
+			Nop()
+		}
+	}
+}
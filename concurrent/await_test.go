@@ -0,0 +1,64 @@
+package concurrent
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Records log entries, prefixing each with a fixed tag standing in for a captured scene.
+type recordingLog struct {
+	lock    sync.Mutex
+	entries []string
+}
+
+func (r *recordingLog) logger(tag string) func(format string, args ...interface{}) {
+	return func(format string, args ...interface{}) {
+		r.lock.Lock()
+		defer r.lock.Unlock()
+		r.entries = append(r.entries, tag+" "+fmt.Sprintf(format, args...))
+	}
+}
+
+func (r *recordingLog) containing(substr string) []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	matching := make([]string, 0)
+	for _, entry := range r.entries {
+		if strings.Contains(entry, substr) {
+			matching = append(matching, entry)
+		}
+	}
+	return matching
+}
+
+func TestAwaitCtxLogged_satisfied(t *testing.T) {
+	r := &recordingLog{}
+	trace, debug := r.logger("[trace loop=test]"), r.logger("[debug loop=test]")
+
+	c := NewAtomicCounter()
+	cond := func() bool {
+		debug("Checking counter")
+		return c.Inc() >= 3
+	}
+
+	assert.True(t, AwaitCtxLogged(context.Background(), cond, trace, time.Microsecond))
+	assert.Len(t, r.containing("[trace loop=test]"), 3)
+	assert.Len(t, r.containing("[debug loop=test]"), 3)
+	assert.Len(t, r.containing("[trace loop=test] Await poll 3: satisfied=true"), 1)
+}
+
+func TestAwaitCtxLogged_cancelled(t *testing.T) {
+	r := &recordingLog{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	assert.False(t, AwaitCtxLogged(ctx, func() bool { return false }, r.logger("[trace]"), time.Microsecond))
+	assert.NotEmpty(t, r.containing("satisfied=false"))
+	assert.Empty(t, r.containing("satisfied=true"))
+}
@@ -0,0 +1,85 @@
+package concurrent
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/obsidiandynamics/libstdgo/arity"
+)
+
+// Backoff produces a geometrically growing sequence of delays for use in retry loops, capped at a maximum. It is
+// decoupled from any particular retry driver; the caller decides when to sleep, for how long, and when to give up.
+//
+// Backoff is thread-safe.
+type Backoff interface {
+	fmt.Stringer
+	Next() time.Duration
+	Reset()
+}
+
+type backoff struct {
+	lock    sync.Mutex
+	initial time.Duration
+	max     time.Duration
+	factor  float64
+	jitter  float64
+	current time.Duration
+}
+
+// NewBackoff creates a new Backoff, where the first delay is initial, and each subsequent delay is the previous one
+// multiplied by factor, but no greater than max. The initial delay must be positive, max must be no less than
+// initial, and factor must be at least 1.
+//
+// An optional jitter, in the range [0, 1], randomly shortens each delay by up to the given fraction; e.g. a jitter
+// of 0.2 yields delays between 80% and 100% of the nominal value. Jitter does not affect the growth of the
+// nominal delay. If unspecified, no jitter is applied.
+func NewBackoff(initial, max time.Duration, factor float64, jitter ...float64) Backoff {
+	j := arity.SoleUntyped(0.0, jitter).(float64)
+	switch {
+	case initial <= 0:
+		panic(fmt.Errorf("initial delay must be greater than 0"))
+	case max < initial:
+		panic(fmt.Errorf("max delay must not be less than the initial delay"))
+	case factor < 1:
+		panic(fmt.Errorf("factor must be at least 1"))
+	case j < 0 || j > 1:
+		panic(fmt.Errorf("jitter must be in the range [0, 1]"))
+	}
+	return &backoff{initial: initial, max: max, factor: factor, jitter: j}
+}
+
+// String obtains a string representation of the backoff.
+func (b *backoff) String() string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return fmt.Sprint("Backoff[Initial=", b.initial, ", Max=", b.max, ", Factor=", b.factor,
+		", Jitter=", b.jitter, ", Current=", b.current, "]")
+}
+
+// Next returns the next delay in the sequence.
+func (b *backoff) Next() time.Duration {
+	b.lock.Lock()
+	if b.current == 0 {
+		b.current = b.initial
+	} else if next := float64(b.current) * b.factor; next < float64(b.max) {
+		b.current = time.Duration(next)
+	} else {
+		b.current = b.max
+	}
+	nominal := b.current
+	b.lock.Unlock()
+
+	if b.jitter == 0 {
+		return nominal
+	}
+	return nominal - time.Duration(rand.Float64()*b.jitter*float64(nominal))
+}
+
+// Reset restarts the sequence, such that the next delay is the initial one.
+func (b *backoff) Reset() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.current = 0
+}
@@ -0,0 +1,66 @@
+package concurrent
+
+import (
+	"testing"
+	"time"
+
+	"github.com/obsidiandynamics/libstdgo/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoff_growsAndCaps(t *testing.T) {
+	b := NewBackoff(10*time.Millisecond, 100*time.Millisecond, 2)
+	expected := []time.Duration{10, 20, 40, 80, 100, 100}
+	for _, e := range expected {
+		assert.Equal(t, e*time.Millisecond, b.Next())
+	}
+}
+
+func TestBackoff_reset(t *testing.T) {
+	b := NewBackoff(time.Millisecond, time.Second, 3)
+	assert.Equal(t, time.Millisecond, b.Next())
+	assert.Equal(t, 3*time.Millisecond, b.Next())
+
+	b.Reset()
+	assert.Equal(t, time.Millisecond, b.Next())
+	assert.Equal(t, 3*time.Millisecond, b.Next())
+}
+
+func TestBackoff_overflowCapsAtMax(t *testing.T) {
+	b := NewBackoff(time.Hour, Indefinitely, 1e10)
+	assert.Equal(t, time.Hour, b.Next())
+	assert.Equal(t, Indefinitely, b.Next())
+	assert.Equal(t, Indefinitely, b.Next())
+}
+
+func TestBackoff_jitter(t *testing.T) {
+	b := NewBackoff(100*time.Millisecond, time.Second, 2, 0.5)
+	for i := 0; i < 100; i++ {
+		b.Reset()
+		first := b.Next()
+		assert.True(t, first > 50*time.Millisecond && first <= 100*time.Millisecond, "first=%v", first)
+		second := b.Next()
+		assert.True(t, second > 100*time.Millisecond && second <= 200*time.Millisecond, "second=%v", second)
+	}
+}
+
+func TestBackoff_invalidArgs(t *testing.T) {
+	check.ThatPanicsAsExpected(t, check.ErrorWithValue("initial delay must be greater than 0"), func() {
+		NewBackoff(0, time.Second, 2)
+	})
+	check.ThatPanicsAsExpected(t, check.ErrorWithValue("max delay must not be less than the initial delay"), func() {
+		NewBackoff(time.Second, time.Millisecond, 2)
+	})
+	check.ThatPanicsAsExpected(t, check.ErrorWithValue("factor must be at least 1"), func() {
+		NewBackoff(time.Millisecond, time.Second, 0.5)
+	})
+	check.ThatPanicsAsExpected(t, check.ErrorWithValue("jitter must be in the range [0, 1]"), func() {
+		NewBackoff(time.Millisecond, time.Second, 2, 1.5)
+	})
+}
+
+func TestBackoff_string(t *testing.T) {
+	b := NewBackoff(time.Millisecond, time.Second, 2)
+	b.Next()
+	assert.Equal(t, "Backoff[Initial=1ms, Max=1s, Factor=2, Jitter=0, Current=1ms]", b.String())
+}
@@ -0,0 +1,126 @@
+package concurrent
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/obsidiandynamics/libstdgo/arity"
+)
+
+// AtomicBool encapsulates a bool value that may updated atomically.
+type AtomicBool interface {
+	fmt.Stringer
+	Get() bool
+	Set(value bool)
+	CompareAndSwap(expected bool, replacement bool) bool
+	Toggle() bool
+	Await(value bool, timeout time.Duration, interval ...time.Duration) bool
+	AwaitCtx(ctx context.Context, value bool, interval ...time.Duration) bool
+}
+
+type atomicBool struct {
+	notify chan int
+	value  int32
+}
+
+// NewAtomicBool creates a new bool, optionally assigning its value to the given initial value (false by default).
+func NewAtomicBool(initial ...bool) AtomicBool {
+	b := &atomicBool{}
+	b.value = boolToInt32(arity.SoleUntyped(false, initial).(bool))
+	b.notify = make(chan int, 1)
+	return b
+}
+
+func boolToInt32(value bool) int32 {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+// String obtains a string representation of the atomic bool.
+func (b *atomicBool) String() string {
+	return fmt.Sprint("AtomicBool[", b.Get(), "]")
+}
+
+// Gets the current value.
+func (b *atomicBool) Get() bool {
+	return atomic.LoadInt32(&b.value) == 1
+}
+
+// Sets a new value.
+func (b *atomicBool) Set(value bool) {
+	defer b.notifyUpdate()
+	atomic.StoreInt32(&b.value, boolToInt32(value))
+}
+
+func (b *atomicBool) notifyUpdate() {
+	select {
+	case b.notify <- 0:
+		Nop()
+	default:
+		Nop()
+	}
+}
+
+// CompareAndSwap conditionally assigns a replacement value if the existing value matched the given
+// expected value.
+func (b *atomicBool) CompareAndSwap(expected bool, replacement bool) bool {
+	if atomic.CompareAndSwapInt32(&b.value, boolToInt32(expected), boolToInt32(replacement)) {
+		b.notifyUpdate()
+		return true
+	}
+	return false
+}
+
+// Toggle atomically inverts the value, returning the updated value.
+func (b *atomicBool) Toggle() bool {
+	defer b.notifyUpdate()
+	for {
+		existing := atomic.LoadInt32(&b.value)
+		if atomic.CompareAndSwapInt32(&b.value, existing, existing^1) {
+			return existing == 0
+		}
+	}
+}
+
+// DefaultBoolCheckInterval is the default check interval used by Await/AwaitCtx.
+const DefaultBoolCheckInterval = 10 * time.Millisecond
+
+// Await blocks until the bool assumes the given value or the timeout expires, returning true if the value was
+// observed. The optional interval argument places an upper bound on the check interval (defaults to
+// DefaultBoolCheckInterval).
+func (b *atomicBool) Await(value bool, timeout time.Duration, interval ...time.Duration) bool {
+	ctx, cancel := Timeout(context.Background(), timeout)
+	defer cancel()
+	return b.AwaitCtx(ctx, value, interval...)
+}
+
+// AwaitCtx blocks until the bool assumes the given value or the context is cancelled, returning true if the value
+// was observed. The optional interval argument places an upper bound on the check interval (defaults to
+// DefaultBoolCheckInterval).
+func (b *atomicBool) AwaitCtx(ctx context.Context, value bool, interval ...time.Duration) bool {
+	checkInterval := optional(DefaultBoolCheckInterval, interval...)
+	var sleepTicker *time.Ticker
+	for {
+		if b.Get() == value {
+			return true
+		}
+
+		if sleepTicker == nil {
+			sleepTicker = time.NewTicker(checkInterval)
+			defer sleepTicker.Stop()
+		}
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-b.notify:
+			Nop()
+		case <-sleepTicker.C:
+			Nop()
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package concurrent
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAtomicBool_default(t *testing.T) {
+	b := NewAtomicBool()
+	assert.False(t, b.Get())
+	assert.Equal(t, "AtomicBool[false]", b.String())
+
+	b = NewAtomicBool(true)
+	assert.True(t, b.Get())
+	assert.Equal(t, "AtomicBool[true]", b.String())
+}
+
+func TestAtomicBool_setAndCompareAndSwap(t *testing.T) {
+	b := NewAtomicBool()
+	b.Set(true)
+	assert.True(t, b.Get())
+
+	assert.False(t, b.CompareAndSwap(false, true))
+	assert.True(t, b.Get())
+	assert.True(t, b.CompareAndSwap(true, false))
+	assert.False(t, b.Get())
+}
+
+func TestAtomicBool_toggle(t *testing.T) {
+	b := NewAtomicBool()
+	assert.True(t, b.Toggle())
+	assert.True(t, b.Get())
+	assert.False(t, b.Toggle())
+	assert.False(t, b.Get())
+}
+
+func TestAtomicBool_concurrentToggle(t *testing.T) {
+	const routines = 8
+	const togglesPerRoutine = 1001
+	b := NewAtomicBool()
+
+	wg := sync.WaitGroup{}
+	wg.Add(routines)
+	for i := 0; i < routines; i++ {
+		go func() {
+			defer wg.Done()
+			for j := 0; j < togglesPerRoutine; j++ {
+				b.Toggle()
+			}
+		}()
+	}
+	wg.Wait()
+
+	// An even number of toggles in total restores the original value.
+	assert.False(t, b.Get())
+}
+
+func TestAtomicBool_await(t *testing.T) {
+	b := NewAtomicBool()
+	assert.True(t, b.Await(false, time.Microsecond))
+	assert.False(t, b.Await(true, time.Microsecond))
+
+	go func() {
+		time.Sleep(time.Millisecond)
+		b.Set(true)
+	}()
+	assert.True(t, b.Await(true, 10*time.Second, time.Millisecond))
+}
@@ -0,0 +1,138 @@
+package concurrent
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/obsidiandynamics/libstdgo/arity"
+)
+
+// DeliveryPolicy determines how a Broadcaster behaves when a subscriber's channel buffer is full.
+type DeliveryPolicy int
+
+const (
+	// DropWhenFull discards a published value for any subscriber whose buffer is full. Publishing never blocks.
+	DropWhenFull DeliveryPolicy = iota
+
+	// BlockWhenFull blocks the publisher until every subscriber has room for the published value, or
+	// until the lagging subscriber unsubscribes.
+	BlockWhenFull
+)
+
+// Broadcaster publishes values to any number of subscribers, where each subscriber receives every published
+// value (subject to the DeliveryPolicy) on its own buffered channel. The most recently published value is
+// retained in an AtomicReference and may be retrieved at any time.
+//
+// Broadcaster is thread-safe.
+type Broadcaster interface {
+	fmt.Stringer
+	Publish(value interface{})
+	Subscribe() <-chan interface{}
+	Unsubscribe(ch <-chan interface{})
+	Latest() AtomicReference
+}
+
+type subscriber struct {
+	ch   chan interface{}
+	done chan int
+}
+
+type broadcaster struct {
+	lock        sync.Mutex
+	publishLock sync.Mutex
+	capacity    int
+	policy      DeliveryPolicy
+	subscribers map[<-chan interface{}]*subscriber
+	latest      AtomicReference
+}
+
+// NewBroadcaster creates a new Broadcaster, where each subscriber is allocated a channel with the given buffer
+// capacity. The optional policy argument determines the behaviour when a subscriber's buffer is full
+// (defaults to DropWhenFull).
+func NewBroadcaster(capacity int, policy ...DeliveryPolicy) Broadcaster {
+	return &broadcaster{
+		capacity:    capacity,
+		policy:      arity.SoleUntyped(DropWhenFull, policy).(DeliveryPolicy),
+		subscribers: make(map[<-chan interface{}]*subscriber),
+		latest:      NewAtomicReference(),
+	}
+}
+
+// String obtains a string representation of the broadcaster.
+func (b *broadcaster) String() string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return fmt.Sprint("Broadcaster[Subscribers=", len(b.subscribers), ", Latest=", b.latest, "]")
+}
+
+// Publish delivers the given value to all current subscribers, and records it as the latest value.
+func (b *broadcaster) Publish(value interface{}) {
+	b.publishLock.Lock()
+	defer b.publishLock.Unlock()
+	b.latest.Set(value)
+	for _, s := range b.snapshot() {
+		if b.policy == BlockWhenFull {
+			select {
+			case s.ch <- value:
+				Nop()
+			case <-s.done:
+				Nop()
+			}
+		} else {
+			select {
+			case s.ch <- value:
+				Nop()
+			default:
+				Nop()
+			}
+		}
+	}
+}
+
+// Subscribe registers a new subscriber, returning a channel on which published values will be received.
+// Only values published after subscribing are delivered.
+func (b *broadcaster) Subscribe() <-chan interface{} {
+	s := &subscriber{
+		ch:   make(chan interface{}, b.capacity),
+		done: make(chan int),
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.subscribers[s.ch] = s
+	return s.ch
+}
+
+// Unsubscribe deregisters the subscriber associated with the given channel, closing the channel. Values that
+// were buffered prior to unsubscribing may still be drained from the channel. Unsubscribing a channel that
+// isn't subscribed has no effect.
+func (b *broadcaster) Unsubscribe(ch <-chan interface{}) {
+	b.lock.Lock()
+	s, ok := b.subscribers[ch]
+	delete(b.subscribers, ch)
+	b.lock.Unlock()
+	if !ok {
+		return
+	}
+
+	// Unblock a publisher that may be waiting on this subscriber, then wait for the publisher to finish before
+	// closing the channel.
+	close(s.done)
+	b.publishLock.Lock()
+	defer b.publishLock.Unlock()
+	close(s.ch)
+}
+
+func (b *broadcaster) snapshot() []*subscriber {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	subscribers := make([]*subscriber, 0, len(b.subscribers))
+	for _, s := range b.subscribers {
+		subscribers = append(subscribers, s)
+	}
+	return subscribers
+}
+
+// Latest returns a reference to the most recently published value (nil if nothing has been published).
+func (b *broadcaster) Latest() AtomicReference {
+	return b.latest
+}
@@ -0,0 +1,109 @@
+package concurrent
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func receive(t *testing.T, ch <-chan interface{}) interface{} {
+	select {
+	case value := <-ch:
+		return value
+	case <-time.After(10 * time.Second):
+		assert.Fail(t, "Timed out waiting for value")
+		return nil
+	}
+}
+
+func assertClosed(t *testing.T, ch <-chan interface{}) {
+	_, ok := <-ch
+	assert.False(t, ok)
+}
+
+func TestBroadcaster_multipleSubscribers(t *testing.T) {
+	b := NewBroadcaster(10)
+	assert.Nil(t, b.Latest().Get())
+
+	s0 := b.Subscribe()
+	s1 := b.Subscribe()
+	assert.Contains(t, b.String(), "Subscribers=2")
+
+	b.Publish("a")
+	b.Publish("b")
+	assert.Equal(t, "b", b.Latest().Get())
+
+	for _, s := range []<-chan interface{}{s0, s1} {
+		assert.Equal(t, "a", receive(t, s))
+		assert.Equal(t, "b", receive(t, s))
+	}
+}
+
+func TestBroadcaster_unsubscribe(t *testing.T) {
+	b := NewBroadcaster(10)
+	s0 := b.Subscribe()
+	s1 := b.Subscribe()
+
+	b.Publish("a")
+	b.Unsubscribe(s0)
+	b.Publish("b")
+
+	// Values buffered prior to unsubscribing are still delivered, after which the channel is closed.
+	assert.Equal(t, "a", receive(t, s0))
+	assertClosed(t, s0)
+
+	assert.Equal(t, "a", receive(t, s1))
+	assert.Equal(t, "b", receive(t, s1))
+
+	// Unsubscribing a second time has no effect.
+	b.Unsubscribe(s0)
+	assert.Contains(t, b.String(), "Subscribers=1")
+}
+
+func TestBroadcaster_dropWhenFull(t *testing.T) {
+	b := NewBroadcaster(1, DropWhenFull)
+	s := b.Subscribe()
+
+	b.Publish("a")
+	b.Publish("b")
+	assert.Equal(t, "a", receive(t, s))
+	assert.Len(t, s, 0)
+	assert.Equal(t, "b", b.Latest().Get())
+}
+
+func TestBroadcaster_blockWhenFull(t *testing.T) {
+	b := NewBroadcaster(1, BlockWhenFull)
+	s := b.Subscribe()
+
+	b.Publish("a")
+	published := NewAtomicCounter()
+	go func() {
+		b.Publish("b")
+		published.Inc()
+	}()
+
+	time.Sleep(1 * time.Millisecond)
+	assert.Equal(t, int64(0), published.Get())
+	assert.Equal(t, "a", receive(t, s))
+	assert.Equal(t, "b", receive(t, s))
+	published.Fill(1, 10*time.Second)
+	assert.Equal(t, int64(1), published.Get())
+}
+
+func TestBroadcaster_unsubscribeUnblocksPublisher(t *testing.T) {
+	b := NewBroadcaster(0, BlockWhenFull)
+	s := b.Subscribe()
+
+	published := NewAtomicCounter()
+	go func() {
+		b.Publish("a")
+		published.Inc()
+	}()
+
+	b.Latest().Await(RefEqual("a"), 10*time.Second)
+	b.Unsubscribe(s)
+	published.Fill(1, 10*time.Second)
+	assert.Equal(t, int64(1), published.Get())
+	assertClosed(t, s)
+}
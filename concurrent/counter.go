@@ -18,11 +18,13 @@ type AtomicCounter interface {
 	Inc() int64
 	Dec() int64
 	Set(amount int64)
+	Flush() int64
 	CompareAndSwap(expected int64, replacement int64) bool
 	Fill(atLeast int64, timeout time.Duration, interval ...time.Duration) int64
 	Drain(atMost int64, timeout time.Duration, interval ...time.Duration) int64
 	Await(cond I64Condition, timeout time.Duration, interval ...time.Duration) int64
 	AwaitCtx(ctx context.Context, cond I64Condition, interval ...time.Duration) int64
+	AwaitResult(cond I64Condition, timeout time.Duration, interval ...time.Duration) AwaitResult
 }
 
 type atomicCounter struct {
@@ -76,6 +78,12 @@ func (c *atomicCounter) Set(amount int64) {
 	atomic.StoreInt64(&c.value, amount)
 }
 
+// Flush atomically resets the counter to zero, returning the value prior to the reset.
+func (c *atomicCounter) Flush() int64 {
+	defer c.notifyUpdate()
+	return atomic.SwapInt64(&c.value, 0)
+}
+
 func (c *atomicCounter) notifyUpdate() {
 	select {
 	case c.notify <- 0:
@@ -142,3 +150,28 @@ func (c *atomicCounter) AwaitCtx(ctx context.Context, cond I64Condition, interva
 		}
 	}
 }
+
+// AwaitResult describes the outcome of AtomicCounter.AwaitResult, for diagnosing awaits that time out.
+type AwaitResult struct {
+	Value    int64         // the last observed value of the counter
+	TimedOut bool          // true if the condition was not met before the timeout expired
+	Elapsed  time.Duration // the time spent awaiting
+}
+
+// String obtains a string representation of the await result.
+func (r AwaitResult) String() string {
+	return fmt.Sprint("AwaitResult[Value=", r.Value, ", TimedOut=", r.TimedOut, ", Elapsed=", r.Elapsed, "]")
+}
+
+// AwaitResult behaves like Await, but returns an AwaitResult comprising the last observed value, whether the await
+// timed out, and the time elapsed. This is useful for reporting how close the counter came to satisfying the
+// condition when an await times out.
+func (c *atomicCounter) AwaitResult(cond I64Condition, timeout time.Duration, interval ...time.Duration) AwaitResult {
+	start := time.Now()
+	value := c.Await(cond, timeout, interval...)
+	return AwaitResult{
+		Value:    value,
+		TimedOut: !cond(value),
+		Elapsed:  time.Since(start),
+	}
+}
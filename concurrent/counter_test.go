@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/obsidiandynamics/libstdgo/check"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -134,6 +135,13 @@ func TestAtomicCounterSet(t *testing.T) {
 	assert.Equal(t, 7, c.GetInt())
 }
 
+func TestAtomicCounterFlush(t *testing.T) {
+	c := NewAtomicCounter(3)
+	assert.Equal(t, int64(3), c.Flush())
+	assert.Equal(t, int64(0), c.Get())
+	assert.Equal(t, int64(0), c.Flush())
+}
+
 func TestAtomicCounterCompareAndSwap(t *testing.T) {
 	c := NewAtomicCounter(3)
 	assert.False(t, c.CompareAndSwap(2, 3))
@@ -146,3 +154,28 @@ func TestAtomicCounterStringer(t *testing.T) {
 	c := NewAtomicCounter(1)
 	assert.Equal(t, "AtomicCounter[1]", c.String())
 }
+
+func TestAtomicCounterAwaitResult_satisfied(t *testing.T) {
+	c := NewAtomicCounter(5)
+	result := c.AwaitResult(I64GreaterThanOrEqual(5), 10*time.Second)
+	assert.Equal(t, int64(5), result.Value)
+	assert.False(t, result.TimedOut)
+	assert.True(t, result.Elapsed < 10*time.Second)
+}
+
+func TestAtomicCounterAwaitResult_timedOut(t *testing.T) {
+	c := NewAtomicCounter(3)
+	result := c.AwaitResult(I64GreaterThanOrEqual(5), time.Millisecond, time.Microsecond)
+	assert.Equal(t, int64(3), result.Value)
+	assert.True(t, result.TimedOut)
+	assert.True(t, result.Elapsed >= time.Millisecond)
+	assert.Contains(t, result.String(), "AwaitResult[Value=3, TimedOut=true, Elapsed=")
+}
+
+func TestAtomicCounterHammer(t *testing.T) {
+	c := NewAtomicCounter()
+	check.Hammer(t, 8, 1000, func(_, _ int) {
+		c.Inc()
+	})
+	assert.Equal(t, int64(8000), c.Get())
+}
@@ -1,6 +1,7 @@
 package concurrent
 
 import (
+	"math/rand"
 	"time"
 )
 
@@ -56,6 +57,17 @@ func NewDeadline(interval time.Duration) Deadline {
 	}
 }
 
+// NewDeadlineWithJitter creates a new Deadline whose effective interval is the given interval plus a random offset
+// in the range [0, jitter). This spreads out the expiry of multiple Deadlines created with the same interval (for
+// example, cache refreshes), avoiding a thundering herd. The offset is chosen once, at construction, and remains
+// fixed for the lifetime of the Deadline. A non-positive jitter yields an ordinary Deadline.
+func NewDeadlineWithJitter(interval time.Duration, jitter time.Duration) Deadline {
+	if jitter > 0 {
+		interval += time.Duration(rand.Int63n(int64(jitter)))
+	}
+	return NewDeadline(interval)
+}
+
 // TryRun conditionally runs the given function if the deadline object has not been exercised
 // for a period that exceeds its set interval. Returns true if the function was executed.
 func (d *deadline) TryRun(f func()) bool {
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/obsidiandynamics/libstdgo/check"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -78,3 +79,32 @@ func TestDeadlineMove(t *testing.T) {
 	assert.True(t, d.TryRun(setter))
 	assert.True(t, called)
 }
+
+func TestDeadlineWithJitter(t *testing.T) {
+	d0 := NewDeadlineWithJitter(time.Hour, time.Hour).(*deadline)
+	d1 := NewDeadlineWithJitter(time.Hour, time.Hour).(*deadline)
+
+	for _, d := range []*deadline{d0, d1} {
+		assert.GreaterOrEqual(t, int64(d.interval), int64(time.Hour))
+		assert.Less(t, int64(d.interval), int64(2*time.Hour))
+	}
+	assert.NotEqual(t, d0.interval, d1.interval)
+
+	// Deadlines that were last run at the same time lapse at different times.
+	now := time.Now()
+	d0.Move(now)
+	d1.Move(now)
+	check.DurationWithinDelta(t, d0.interval-d1.interval, d0.Remaining()-d1.Remaining(), time.Second)
+
+	// The jitter is fixed for the lifetime of the deadline.
+	interval := d0.interval
+	d0.Move(zeroTime)
+	assert.True(t, d0.TryRun(func() {}))
+	assert.False(t, d0.TryRun(func() {}))
+	assert.Equal(t, interval, d0.interval)
+}
+
+func TestDeadlineWithJitter_noJitter(t *testing.T) {
+	d := NewDeadlineWithJitter(time.Hour, 0).(*deadline)
+	assert.Equal(t, time.Hour, d.interval)
+}
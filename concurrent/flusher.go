@@ -0,0 +1,41 @@
+package concurrent
+
+import (
+	"sync"
+	"time"
+)
+
+// PeriodicFlusher starts a background goroutine that, at the given interval, flushes the counter (atomically reading
+// and resetting it, as per AtomicCounter.Flush) and invokes fn with the flushed delta. This suits the periodic
+// reporting of metrics, where the counter accumulates events between reports. The callback is invoked on every
+// tick, including those where the delta is zero.
+//
+// The returned function stops the flusher, performing a final flush of the partial interval before returning.
+// It is safe to call more than once; subsequent calls have no effect. Calls to fn are made from a single goroutine,
+// and none are made once the stop function has returned.
+func PeriodicFlusher(counter AtomicCounter, interval time.Duration, fn func(delta int64)) (stop func()) {
+	stopRequested := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				fn(counter.Flush())
+			case <-stopRequested:
+				fn(counter.Flush())
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(stopRequested)
+		})
+		<-stopped
+	}
+}
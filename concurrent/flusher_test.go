@@ -0,0 +1,62 @@
+package concurrent
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type deltaRecorder struct {
+	lock   sync.Mutex
+	deltas []int64
+	total  AtomicCounter
+}
+
+func newDeltaRecorder() *deltaRecorder {
+	return &deltaRecorder{total: NewAtomicCounter()}
+}
+
+func (r *deltaRecorder) record(delta int64) {
+	r.lock.Lock()
+	r.deltas = append(r.deltas, delta)
+	r.lock.Unlock()
+	r.total.Add(delta)
+}
+
+func (r *deltaRecorder) list() []int64 {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]int64{}, r.deltas...)
+}
+
+func TestPeriodicFlusher_flushOnTick(t *testing.T) {
+	c := NewAtomicCounter()
+	r := newDeltaRecorder()
+	stop := PeriodicFlusher(c, time.Millisecond, r.record)
+	defer stop()
+
+	c.Add(5)
+	assert.Equal(t, int64(5), r.total.Fill(5, 10*time.Second))
+	assert.Equal(t, int64(0), c.Get())
+
+	c.Add(3)
+	assert.Equal(t, int64(8), r.total.Fill(8, 10*time.Second))
+}
+
+func TestPeriodicFlusher_finalFlushOnStop(t *testing.T) {
+	c := NewAtomicCounter()
+	r := newDeltaRecorder()
+	stop := PeriodicFlusher(c, Indefinitely, r.record)
+
+	c.Add(7)
+	stop()
+	assert.Equal(t, []int64{7}, r.list())
+
+	// Stopping again has no effect.
+	c.Add(1)
+	stop()
+	assert.Equal(t, []int64{7}, r.list())
+	assert.Equal(t, int64(1), c.Get())
+}
@@ -0,0 +1,52 @@
+package concurrent
+
+import (
+	"context"
+	"fmt"
+)
+
+// Future is the eventual result of an asynchronous computation, started with Go. A Future resolves exactly once;
+// all callers of Await observe the same result.
+type Future interface {
+	Await(ctx context.Context) (interface{}, error)
+	Done() <-chan struct{}
+}
+
+type future struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+// Go runs fn in a new goroutine, returning a Future that resolves with its result. If fn panics, the Future resolves
+// with an error describing the panic, rather than crashing the process.
+func Go(fn func() (interface{}, error)) Future {
+	f := &future{done: make(chan struct{})}
+	go func() {
+		defer close(f.done)
+		defer func() {
+			if cause := recover(); cause != nil {
+				f.value, f.err = nil, fmt.Errorf("future panicked: %v", cause)
+			}
+		}()
+		f.value, f.err = fn()
+	}()
+	return f
+}
+
+// Await blocks until the Future resolves, returning the result of the computation, or until the context is cancelled,
+// in which case nil and the context's error are returned. Cancelling an Await does not affect the computation, which
+// may be awaited again.
+func (f *future) Await(ctx context.Context) (interface{}, error) {
+	select {
+	case <-f.done:
+		return f.value, f.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Done returns a channel that is closed when the Future resolves.
+func (f *future) Done() <-chan struct{} {
+	return f.done
+}
@@ -0,0 +1,87 @@
+package concurrent
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/obsidiandynamics/libstdgo/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuture_success(t *testing.T) {
+	f := Go(func() (interface{}, error) {
+		return 42, nil
+	})
+	value, err := f.Await(context.Background())
+	assert.Equal(t, 42, value)
+	assert.Nil(t, err)
+	_, open := <-f.Done()
+	assert.False(t, open)
+}
+
+func TestFuture_error(t *testing.T) {
+	f := Go(func() (interface{}, error) {
+		return nil, check.ErrSimulated
+	})
+	value, err := f.Await(context.Background())
+	assert.Nil(t, value)
+	assert.Equal(t, check.ErrSimulated, err)
+}
+
+func TestFuture_panic(t *testing.T) {
+	f := Go(func() (interface{}, error) {
+		panic("boom")
+	})
+	value, err := f.Await(context.Background())
+	assert.Nil(t, value)
+	assert.EqualError(t, err, "future panicked: boom")
+}
+
+func TestFuture_multipleAwaiters(t *testing.T) {
+	release := make(chan struct{})
+	runs := NewAtomicCounter()
+	f := Go(func() (interface{}, error) {
+		runs.Inc()
+		<-release
+		return "result", nil
+	})
+
+	const awaiters = 8
+	wg := sync.WaitGroup{}
+	wg.Add(awaiters)
+	for i := 0; i < awaiters; i++ {
+		go func() {
+			defer wg.Done()
+			value, err := f.Await(context.Background())
+			assert.Equal(t, "result", value)
+			assert.Nil(t, err)
+		}()
+	}
+
+	check.ChanBlocks(t, f.Done(), time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int64(1), runs.Get())
+}
+
+func TestFuture_awaitCancellation(t *testing.T) {
+	release := make(chan struct{})
+	f := Go(func() (interface{}, error) {
+		<-release
+		return "late", nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	value, err := f.Await(ctx)
+	assert.Nil(t, value)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	// The computation is unaffected and may be awaited again.
+	close(release)
+	value, err = f.Await(context.Background())
+	assert.Equal(t, "late", value)
+	assert.Nil(t, err)
+}
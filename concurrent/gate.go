@@ -0,0 +1,92 @@
+package concurrent
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/obsidiandynamics/libstdgo/arity"
+)
+
+// Gate controls the progress of goroutines that call Pass: while the gate is closed, Pass blocks; once the gate
+// is opened, all blocked callers proceed, as do subsequent callers until the gate is closed again. This allows,
+// for example, tests to deterministically pause worker goroutines at a known point.
+//
+// Gate is thread-safe.
+type Gate interface {
+	fmt.Stringer
+	Open()
+	Close()
+	IsOpen() bool
+	Pass(ctx context.Context) error
+}
+
+type gate struct {
+	lock sync.Mutex
+	// Closed (in the channel sense) while the gate is open, releasing all receivers.
+	opened chan struct{}
+}
+
+// NewGate creates a new Gate, optionally specifying whether it is initially open (false by default).
+func NewGate(open ...bool) Gate {
+	g := &gate{opened: make(chan struct{})}
+	if arity.SoleUntyped(false, open).(bool) {
+		g.Open()
+	}
+	return g
+}
+
+// String obtains a string representation of the gate.
+func (g *gate) String() string {
+	return fmt.Sprint("Gate[Open=", g.IsOpen(), "]")
+}
+
+// Open opens the gate, releasing any goroutines blocked in Pass. Opening an open gate has no effect.
+func (g *gate) Open() {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if !isClosed(g.opened) {
+		close(g.opened)
+	}
+}
+
+// Close closes the gate, such that subsequent calls to Pass block until the gate is reopened. Closing a closed
+// gate has no effect.
+func (g *gate) Close() {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if isClosed(g.opened) {
+		g.opened = make(chan struct{})
+	}
+}
+
+// IsOpen returns true if the gate is open.
+func (g *gate) IsOpen() bool {
+	return isClosed(g.current())
+}
+
+// Pass returns immediately if the gate is open; otherwise, it blocks until the gate is opened or the context is
+// cancelled, in which case the context's error is returned.
+func (g *gate) Pass(ctx context.Context) error {
+	select {
+	case <-g.current():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (g *gate) current() chan struct{} {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	return g.opened
+}
+
+func isClosed(ch chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,66 @@
+package concurrent
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGate_blocksWhileClosedAndReleasesAllOnOpen(t *testing.T) {
+	g := NewGate()
+	assert.False(t, g.IsOpen())
+	assert.Equal(t, "Gate[Open=false]", g.String())
+
+	const workers = 8
+	passed := NewAtomicCounter()
+	wg := sync.WaitGroup{}
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			assert.Nil(t, g.Pass(context.Background()))
+			passed.Inc()
+		}()
+	}
+
+	time.Sleep(time.Millisecond)
+	assert.Equal(t, int64(0), passed.Get())
+
+	g.Open()
+	assert.True(t, g.IsOpen())
+	wg.Wait()
+	assert.Equal(t, int64(workers), passed.Get())
+
+	// An open gate does not block.
+	assert.Nil(t, g.Pass(context.Background()))
+}
+
+func TestGate_reclose(t *testing.T) {
+	g := NewGate(true)
+	assert.True(t, g.IsOpen())
+	g.Open()
+	assert.True(t, g.IsOpen())
+
+	g.Close()
+	g.Close()
+	assert.False(t, g.IsOpen())
+
+	passed := NewAtomicBool()
+	go func() {
+		assert.Nil(t, g.Pass(context.Background()))
+		passed.Set(true)
+	}()
+	assert.False(t, passed.Await(true, time.Millisecond))
+	g.Open()
+	assert.True(t, passed.Await(true, 10*time.Second))
+}
+
+func TestGate_contextCancellation(t *testing.T) {
+	g := NewGate()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, g.Pass(ctx))
+}
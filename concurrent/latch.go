@@ -0,0 +1,62 @@
+package concurrent
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// CountDownLatch allows one or more goroutines to wait until a set of operations being performed in other
+// goroutines completes. The latch is initialised with a count, which is decremented by calls to CountDown; waiters
+// are released once the count reaches zero. Unlike a sync.WaitGroup, the count is observable and waiting may be
+// bounded by a timeout or a context.
+type CountDownLatch interface {
+	fmt.Stringer
+	CountDown()
+	Count() int64
+	Await(timeout time.Duration, interval ...time.Duration) bool
+	AwaitCtx(ctx context.Context, interval ...time.Duration) bool
+}
+
+type countDownLatch struct {
+	counter AtomicCounter
+}
+
+// NewCountDownLatch creates a new latch, initialised with the given count.
+func NewCountDownLatch(count int64) CountDownLatch {
+	return &countDownLatch{NewAtomicCounter(count)}
+}
+
+// String obtains a string representation of the latch.
+func (l *countDownLatch) String() string {
+	return fmt.Sprint("CountDownLatch[", l.Count(), "]")
+}
+
+// CountDown decrements the count, releasing all waiting goroutines if the count reaches zero. If the count is
+// already zero, this method has no effect.
+func (l *countDownLatch) CountDown() {
+	for {
+		count := l.counter.Get()
+		if count <= 0 || l.counter.CompareAndSwap(count, count-1) {
+			return
+		}
+	}
+}
+
+// Count returns the current count.
+func (l *countDownLatch) Count() int64 {
+	return l.counter.Get()
+}
+
+// Await blocks until the count reaches zero or the timeout expires, returning true if the count reached zero. The
+// optional interval argument places an upper bound on the check interval (defaults to DefaultCounterCheckInterval).
+func (l *countDownLatch) Await(timeout time.Duration, interval ...time.Duration) bool {
+	return l.counter.Await(I64LessThanOrEqual(0), timeout, interval...) <= 0
+}
+
+// AwaitCtx blocks until the count reaches zero or the context is cancelled, returning true if the count reached
+// zero. The optional interval argument places an upper bound on the check interval (defaults to
+// DefaultCounterCheckInterval).
+func (l *countDownLatch) AwaitCtx(ctx context.Context, interval ...time.Duration) bool {
+	return l.counter.AwaitCtx(ctx, I64LessThanOrEqual(0), interval...) <= 0
+}
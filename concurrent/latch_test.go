@@ -0,0 +1,45 @@
+package concurrent
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountDownLatch(t *testing.T) {
+	const parties = 8
+	l := NewCountDownLatch(parties)
+	assert.Equal(t, int64(parties), l.Count())
+	assert.Equal(t, "CountDownLatch[8]", l.String())
+	assert.False(t, l.Await(time.Microsecond))
+
+	released := NewAtomicBool()
+	go func() {
+		if l.Await(10 * time.Second) {
+			released.Set(true)
+		}
+	}()
+
+	for i := 0; i < parties; i++ {
+		go l.CountDown()
+	}
+	assert.True(t, l.Await(10*time.Second))
+	assert.Equal(t, int64(0), l.Count())
+	assert.True(t, released.Await(true, 10*time.Second))
+
+	// Counting down beyond zero has no effect.
+	l.CountDown()
+	assert.Equal(t, int64(0), l.Count())
+}
+
+func TestCountDownLatch_awaitCtx(t *testing.T) {
+	l := NewCountDownLatch(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.False(t, l.AwaitCtx(ctx))
+
+	l.CountDown()
+	assert.True(t, l.AwaitCtx(context.Background()))
+}
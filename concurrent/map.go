@@ -0,0 +1,131 @@
+package concurrent
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/obsidiandynamics/libstdgo/arity"
+)
+
+// Map is a general-purpose concurrent map of string keys to arbitrary values. It is akin to sync.Map, but its
+// contents are partitioned among a fixed number of individually locked shards (as per Scoreboard), giving
+// predictable behaviour under contention, and its size can be queried.
+type Map interface {
+	fmt.Stringer
+	Store(key string, value interface{})
+	Load(key string) (interface{}, bool)
+	LoadOrStore(key string, value interface{}) (actual interface{}, loaded bool)
+	Delete(key string)
+	Range(f func(key string, value interface{}) bool)
+	Len() int
+}
+
+type mapShard struct {
+	lock    sync.Mutex
+	entries map[string]interface{}
+}
+
+type shardedMap struct {
+	shards []*mapShard
+}
+
+// NewMap creates a new, empty Map with an optionally specified concurrency level, controlling the number of internal
+// shards. If unspecified, concurrency is set to DefaultConcurrency.
+func NewMap(concurrency ...int) Map {
+	conc := arity.SoleUntyped(DefaultConcurrency, concurrency).(int)
+	m := &shardedMap{
+		shards: make([]*mapShard, conc),
+	}
+	for i := 0; i < conc; i++ {
+		m.shards[i] = &mapShard{entries: make(map[string]interface{})}
+	}
+	return m
+}
+
+// String obtains a string representation of the map.
+func (m *shardedMap) String() string {
+	view := make(map[string]interface{})
+	m.Range(func(key string, value interface{}) bool {
+		view[key] = value
+		return true
+	})
+	return fmt.Sprint("Map[", view, "]")
+}
+
+// Store sets the value for the given key, replacing any existing value.
+func (m *shardedMap) Store(key string, value interface{}) {
+	shard := m.forKey(key)
+	shard.lock.Lock()
+	defer shard.lock.Unlock()
+	shard.entries[key] = value
+}
+
+// Load returns the value stored for the given key, if any. The flag indicates whether a value was found.
+func (m *shardedMap) Load(key string) (interface{}, bool) {
+	shard := m.forKey(key)
+	shard.lock.Lock()
+	defer shard.lock.Unlock()
+	value, ok := shard.entries[key]
+	return value, ok
+}
+
+// LoadOrStore returns the existing value for the given key if present. Otherwise, it stores and returns the given
+// value. The loaded flag is true if the value was loaded, and false if it was stored. The check and the store are
+// performed atomically.
+func (m *shardedMap) LoadOrStore(key string, value interface{}) (actual interface{}, loaded bool) {
+	shard := m.forKey(key)
+	shard.lock.Lock()
+	defer shard.lock.Unlock()
+	if existing, ok := shard.entries[key]; ok {
+		return existing, true
+	}
+	shard.entries[key] = value
+	return value, false
+}
+
+// Delete removes the value for the given key, if present.
+func (m *shardedMap) Delete(key string) {
+	shard := m.forKey(key)
+	shard.lock.Lock()
+	defer shard.lock.Unlock()
+	delete(shard.entries, key)
+}
+
+// Range calls f for each key and value in the map, stopping if f returns false. Each shard is copied under lock
+// before its entries are visited, so f may safely modify the map. As the shards are visited in turn, Range does not
+// reflect a consistent snapshot of the entire map; an entry stored or deleted concurrently may or may not be visited.
+func (m *shardedMap) Range(f func(key string, value interface{}) bool) {
+	for _, shard := range m.shards {
+		for key, value := range shard.copy() {
+			if !f(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// Len returns the number of entries in the map. As with Range, the shards are counted in turn; the result may not
+// reflect concurrent modifications.
+func (m *shardedMap) Len() int {
+	length := 0
+	for _, shard := range m.shards {
+		shard.lock.Lock()
+		length += len(shard.entries)
+		shard.lock.Unlock()
+	}
+	return length
+}
+
+func (m *shardedMap) forKey(key string) *mapShard {
+	return m.shards[hash(key)%uint32(len(m.shards))]
+}
+
+func (s *mapShard) copy() map[string]interface{} {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	entries := make(map[string]interface{}, len(s.entries))
+	for k, v := range s.entries {
+		entries[k] = v
+	}
+	return entries
+}
@@ -0,0 +1,122 @@
+package concurrent
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/obsidiandynamics/libstdgo/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapStoreLoadDelete(t *testing.T) {
+	m := NewMap()
+	assert.Equal(t, 0, m.Len())
+
+	value, ok := m.Load("a")
+	assert.Nil(t, value)
+	assert.False(t, ok)
+
+	m.Store("a", 1)
+	m.Store("b", "two")
+	m.Store("c", nil)
+	assert.Equal(t, 3, m.Len())
+
+	value, ok = m.Load("a")
+	assert.Equal(t, 1, value)
+	assert.True(t, ok)
+
+	value, ok = m.Load("c")
+	assert.Nil(t, value)
+	assert.True(t, ok)
+
+	m.Store("a", 3)
+	value, _ = m.Load("a")
+	assert.Equal(t, 3, value)
+	assert.Equal(t, 3, m.Len())
+
+	m.Delete("a")
+	m.Delete("z")
+	_, ok = m.Load("a")
+	assert.False(t, ok)
+	assert.Equal(t, 2, m.Len())
+}
+
+func TestMapLoadOrStore(t *testing.T) {
+	m := NewMap(1)
+	actual, loaded := m.LoadOrStore("a", 1)
+	assert.Equal(t, 1, actual)
+	assert.False(t, loaded)
+
+	actual, loaded = m.LoadOrStore("a", 2)
+	assert.Equal(t, 1, actual)
+	assert.True(t, loaded)
+}
+
+func TestMapRange(t *testing.T) {
+	m := NewMap(4)
+	for i := 0; i < 10; i++ {
+		m.Store(strconv.Itoa(i), i)
+	}
+
+	visited := map[string]interface{}{}
+	m.Range(func(key string, value interface{}) bool {
+		visited[key] = value
+		// Modifying the map from within Range must not deadlock.
+		m.Store(key, value.(int)*2)
+		return true
+	})
+	assert.Len(t, visited, 10)
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, i, visited[strconv.Itoa(i)])
+		value, _ := m.Load(strconv.Itoa(i))
+		assert.Equal(t, i*2, value)
+	}
+
+	count := 0
+	m.Range(func(key string, value interface{}) bool {
+		count++
+		return count < 3
+	})
+	assert.Equal(t, 3, count)
+}
+
+func TestMapString(t *testing.T) {
+	m := NewMap()
+	m.Store("a", 1)
+	assert.Equal(t, "Map[map[a:1]]", m.String())
+}
+
+func TestMapConcurrentStoreAndLoad(t *testing.T) {
+	m := NewMap()
+	const goroutines = 16
+	const keysPerGoroutine = 100
+
+	check.Hammer(t, goroutines, keysPerGoroutine, func(g, i int) {
+		key := strconv.Itoa(g*keysPerGoroutine + i)
+		m.Store(key, i)
+		value, ok := m.Load(key)
+		assert.True(t, ok)
+		assert.Equal(t, i, value)
+		if i%2 == 0 {
+			m.Delete(key)
+		}
+	})
+
+	assert.Equal(t, goroutines*keysPerGoroutine/2, m.Len())
+}
+
+func TestMapConcurrentLoadOrStore(t *testing.T) {
+	m := NewMap()
+	const goroutines = 16
+	const keys = 100
+
+	stores := NewAtomicCounter()
+	check.Hammer(t, goroutines, keys, func(g, i int) {
+		if _, loaded := m.LoadOrStore(strconv.Itoa(i), g); !loaded {
+			stores.Inc()
+		}
+	})
+
+	assert.Equal(t, int64(keys), stores.Get())
+	assert.Equal(t, keys, m.Len())
+}
@@ -0,0 +1,84 @@
+package concurrent
+
+import (
+	"sync"
+	"time"
+
+	"github.com/obsidiandynamics/libstdgo/arity"
+)
+
+// Memoizer caches the successful results of computations by key, for a fixed time-to-live (TTL). Concurrent
+// computations for the same key are deduplicated, as per SingleFlight, such that a missing or expired entry is
+// computed at most once, no matter how many callers request it at the same time.
+type Memoizer interface {
+	Get(key string, compute func() (interface{}, error)) (interface{}, error)
+}
+
+type memoEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+type memoShard struct {
+	lock    sync.Mutex
+	entries map[string]memoEntry
+}
+
+type memoizer struct {
+	ttl    time.Duration
+	flight SingleFlight
+	shards []*memoShard
+}
+
+// NewMemoizer creates a new Memoizer that retains results for the given TTL, with an optionally specified
+// concurrency level, controlling the number of internal shards. If unspecified, concurrency is set to
+// DefaultConcurrency.
+func NewMemoizer(ttl time.Duration, concurrency ...int) Memoizer {
+	conc := arity.SoleUntyped(DefaultConcurrency, concurrency).(int)
+	m := &memoizer{
+		ttl:    ttl,
+		flight: NewSingleFlight(conc),
+		shards: make([]*memoShard, conc),
+	}
+	for i := 0; i < conc; i++ {
+		m.shards[i] = &memoShard{entries: make(map[string]memoEntry)}
+	}
+	return m
+}
+
+// Get returns the cached value for the key if one exists and has not expired. Otherwise, it invokes compute (or
+// waits for an in-flight computation for the same key to complete), caching the result if no error was returned.
+// Errors are not cached; the next call for the key will compute afresh.
+//
+// Expired entries are not proactively evicted; an expired entry is replaced when its key is next requested.
+func (m *memoizer) Get(key string, compute func() (interface{}, error)) (interface{}, error) {
+	shard := m.shards[hash(key)%uint32(len(m.shards))]
+	if value, ok := shard.lookup(key); ok {
+		return value, nil
+	}
+
+	value, err, _ := m.flight.Do(key, func() (interface{}, error) {
+		// A flight for the same key may have completed between the lookup and the start of this flight.
+		if value, ok := shard.lookup(key); ok {
+			return value, nil
+		}
+
+		value, err := compute()
+		if err == nil {
+			shard.lock.Lock()
+			shard.entries[key] = memoEntry{value, time.Now().Add(m.ttl)}
+			shard.lock.Unlock()
+		}
+		return value, err
+	})
+	return value, err
+}
+
+func (s *memoShard) lookup(key string) (interface{}, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if entry, ok := s.entries[key]; ok && time.Now().Before(entry.expires) {
+		return entry.value, true
+	}
+	return nil, false
+}
@@ -0,0 +1,95 @@
+package concurrent
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/obsidiandynamics/libstdgo/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoizer_cacheHitWithinTTL(t *testing.T) {
+	m := NewMemoizer(Indefinitely)
+	computations := NewAtomicCounter()
+	compute := func() (interface{}, error) {
+		return computations.Inc(), nil
+	}
+
+	for i := 0; i < 3; i++ {
+		value, err := m.Get("a", compute)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), value)
+	}
+	value, err := m.Get("b", compute)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), value)
+	assert.Equal(t, int64(2), computations.Get())
+}
+
+func TestMemoizer_recomputeAfterExpiry(t *testing.T) {
+	m := NewMemoizer(time.Millisecond)
+	computations := NewAtomicCounter()
+	compute := func() (interface{}, error) {
+		return computations.Inc(), nil
+	}
+
+	value, err := m.Get("a", compute)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), value)
+
+	time.Sleep(2 * time.Millisecond)
+	value, err = m.Get("a", compute)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), value)
+}
+
+func TestMemoizer_errorNotCached(t *testing.T) {
+	m := NewMemoizer(Indefinitely, 1)
+	value, err := m.Get("a", func() (interface{}, error) {
+		return nil, check.ErrSimulated
+	})
+	assert.Nil(t, value)
+	assert.Equal(t, check.ErrSimulated, err)
+
+	value, err = m.Get("a", func() (interface{}, error) {
+		return "ok", nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "ok", value)
+}
+
+func TestMemoizer_oneComputationPerKeyUnderConcurrency(t *testing.T) {
+	m := NewMemoizer(Indefinitely)
+	const keys = 4
+	const callersPerKey = 16
+	computations := NewScoreboard()
+	release := make(chan struct{})
+
+	wg := sync.WaitGroup{}
+	for k := 0; k < keys; k++ {
+		key := string(rune('a' + k))
+		for c := 0; c < callersPerKey; c++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				value, err := m.Get(key, func() (interface{}, error) {
+					computations.Inc(key)
+					<-release
+					return key, nil
+				})
+				assert.Nil(t, err)
+				assert.Equal(t, key, value)
+			}()
+		}
+	}
+
+	computations.Fill("a", 1, 10*time.Second)
+	time.Sleep(time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	for k := 0; k < keys; k++ {
+		assert.Equal(t, int64(1), computations.Get(string(rune('a'+k))))
+	}
+}
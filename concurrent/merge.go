@@ -0,0 +1,31 @@
+package concurrent
+
+import "sync"
+
+// Merge fans multiple notification channels into a single channel. Each signal received on any of the inputs is
+// forwarded to the returned channel, which is closed once all of the inputs have been closed. Merging zero channels
+// yields a channel that is closed immediately.
+//
+// A forwarding goroutine is started for each input and runs until that input is closed; signals are forwarded
+// in the order they are received on each input, but no ordering is guaranteed across inputs. The caller must
+// continue to drain the merged channel for as long as the inputs are active, otherwise the forwarding goroutines
+// will block.
+func Merge(chans ...<-chan int) <-chan int {
+	merged := make(chan int, len(chans))
+	wg := sync.WaitGroup{}
+	wg.Add(len(chans))
+	for _, ch := range chans {
+		go func(ch <-chan int) {
+			defer wg.Done()
+			for signal := range ch {
+				merged <- signal
+			}
+		}(ch)
+	}
+
+	go func() {
+		wg.Wait()
+		close(merged)
+	}()
+	return merged
+}
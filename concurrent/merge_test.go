@@ -0,0 +1,51 @@
+package concurrent
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func receiveSignal(t *testing.T, ch <-chan int) (int, bool) {
+	select {
+	case signal, ok := <-ch:
+		return signal, ok
+	case <-time.After(10 * time.Second):
+		assert.Fail(t, "Timed out waiting for signal")
+		return 0, false
+	}
+}
+
+func TestMerge(t *testing.T) {
+	in0 := make(chan int)
+	in1 := make(chan int)
+	merged := Merge(in0, in1)
+
+	in0 <- 0
+	signal, ok := receiveSignal(t, merged)
+	assert.True(t, ok)
+	assert.Equal(t, 0, signal)
+
+	in1 <- 1
+	signal, ok = receiveSignal(t, merged)
+	assert.True(t, ok)
+	assert.Equal(t, 1, signal)
+
+	// Closing one of the inputs doesn't close the merged channel.
+	close(in0)
+	in1 <- 2
+	signal, ok = receiveSignal(t, merged)
+	assert.True(t, ok)
+	assert.Equal(t, 2, signal)
+
+	// Closing the last input closes the merged channel.
+	close(in1)
+	_, ok = receiveSignal(t, merged)
+	assert.False(t, ok)
+}
+
+func TestMerge_none(t *testing.T) {
+	_, ok := receiveSignal(t, Merge())
+	assert.False(t, ok)
+}
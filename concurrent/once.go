@@ -0,0 +1,48 @@
+package concurrent
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// OnceValue performs a lazy initialization that may fail. Unlike sync.Once, only a successful outcome is cached;
+// if the initialization function returns an error, it will be invoked again on the next call to Do.
+//
+// OnceValue is thread-safe; concurrent callers are serialised, such that at most one initialization is in
+// progress at any given time.
+type OnceValue interface {
+	Do(fn func() (interface{}, error)) (interface{}, error)
+}
+
+type onceValue struct {
+	lock  sync.Mutex
+	done  uint32
+	value interface{}
+}
+
+// NewOnceValue creates a new, uninitialized OnceValue.
+func NewOnceValue() OnceValue {
+	return &onceValue{}
+}
+
+// Do returns the cached value if a prior initialization succeeded. Otherwise, it invokes fn, caching its result
+// if no error was returned. The error (if any) is returned to the caller, along with the value produced by fn.
+func (o *onceValue) Do(fn func() (interface{}, error)) (interface{}, error) {
+	if atomic.LoadUint32(&o.done) == 1 {
+		return o.value, nil
+	}
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	if o.done == 1 {
+		return o.value, nil
+	}
+
+	value, err := fn()
+	if err != nil {
+		return value, err
+	}
+	o.value = value
+	atomic.StoreUint32(&o.done, 1)
+	return value, nil
+}
@@ -0,0 +1,88 @@
+package concurrent
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/obsidiandynamics/libstdgo/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOnceValue_cachesSuccess(t *testing.T) {
+	o := NewOnceValue()
+	calls := 0
+	init := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	value, err := o.Do(init)
+	assert.Equal(t, 1, value)
+	assert.Nil(t, err)
+
+	value, err = o.Do(init)
+	assert.Equal(t, 1, value)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestOnceValue_retriesFailure(t *testing.T) {
+	o := NewOnceValue()
+	calls := 0
+	init := func() (interface{}, error) {
+		calls++
+		if calls < 3 {
+			return nil, check.ErrSimulated
+		}
+		return "ready", nil
+	}
+
+	for i := 0; i < 2; i++ {
+		value, err := o.Do(init)
+		assert.Nil(t, value)
+		assert.Equal(t, check.ErrSimulated, err)
+	}
+
+	value, err := o.Do(init)
+	assert.Equal(t, "ready", value)
+	assert.Nil(t, err)
+
+	value, err = o.Do(init)
+	assert.Equal(t, "ready", value)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestOnceValue_concurrentInitialization(t *testing.T) {
+	o := NewOnceValue()
+	calls := NewAtomicCounter()
+	running := NewAtomicCounter()
+	init := func() (interface{}, error) {
+		assert.Equal(t, int64(1), running.Inc())
+		defer running.Dec()
+		time.Sleep(1 * time.Millisecond)
+		if calls.Inc() == 1 {
+			return nil, check.ErrSimulated
+		}
+		return "ready", nil
+	}
+
+	const callers = 8
+	wg := sync.WaitGroup{}
+	wg.Add(callers)
+	successes := NewAtomicCounter()
+	for i := 0; i < callers; i++ {
+		go func() {
+			defer wg.Done()
+			if value, err := o.Do(init); err == nil {
+				assert.Equal(t, "ready", value)
+				successes.Inc()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int64(2), calls.Get())
+	assert.Equal(t, int64(callers-1), successes.Get())
+}
@@ -0,0 +1,79 @@
+package concurrent
+
+import (
+	"fmt"
+
+	"github.com/obsidiandynamics/libstdgo/arity"
+)
+
+// Pool is a thread-safe store of reusable items, such as buffers or connections, that are expensive to construct.
+// Get returns an idle item if one is available, otherwise constructing a new one; Put returns an item to the pool for
+// reuse. Unlike sync.Pool, idle items are retained until taken (they are never cleared by the garbage collector),
+// and the number of idle items is capped, so that a burst of activity does not permanently inflate the pool.
+type Pool interface {
+	fmt.Stringer
+	Get() interface{}
+	Put(item interface{})
+	Len() int
+	Cap() int
+}
+
+type pool struct {
+	items chan interface{}
+	ctor  func() interface{}
+}
+
+// DefaultPoolSize is the default number of idle items retained by a Pool.
+const DefaultPoolSize = 16
+
+// NewPool creates a new, empty pool that uses ctor to construct items on demand. The optional maxSize limits the
+// number of idle items retained by the pool; items put into a full pool are discarded. If unspecified, maxSize is set
+// to DefaultPoolSize. The max size must be greater than 0.
+//
+// The max size does not bound the number of items in use at any one time, as Get never blocks.
+func NewPool(ctor func() interface{}, maxSize ...int) Pool {
+	size := arity.SoleUntyped(DefaultPoolSize, maxSize).(int)
+	if size <= 0 {
+		panic(fmt.Errorf("max size must be greater than 0"))
+	}
+	return &pool{
+		items: make(chan interface{}, size),
+		ctor:  ctor,
+	}
+}
+
+// String obtains a string representation of the pool.
+func (p *pool) String() string {
+	return fmt.Sprint("Pool[Len=", p.Len(), ", Cap=", p.Cap(), "]")
+}
+
+// Get takes an idle item from the pool if one is available, otherwise returning a newly constructed item.
+func (p *pool) Get() interface{} {
+	select {
+	case item := <-p.items:
+		return item
+	default:
+		return p.ctor()
+	}
+}
+
+// Put returns an item to the pool, discarding it if the pool already holds its maximum number of idle items. Items
+// should be reset, as required, before being returned.
+func (p *pool) Put(item interface{}) {
+	select {
+	case p.items <- item:
+		Nop()
+	default:
+		Nop()
+	}
+}
+
+// Len returns the number of idle items in the pool.
+func (p *pool) Len() int {
+	return len(p.items)
+}
+
+// Cap returns the maximum number of idle items retained by the pool.
+func (p *pool) Cap() int {
+	return cap(p.items)
+}
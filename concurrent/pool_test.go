@@ -0,0 +1,95 @@
+package concurrent
+
+import (
+	"testing"
+
+	"github.com/obsidiandynamics/libstdgo/check"
+	"github.com/stretchr/testify/assert"
+)
+
+type pooled struct {
+	id    int64
+	inUse AtomicCounter
+}
+
+func counting(constructed AtomicCounter) func() interface{} {
+	return func() interface{} {
+		return &pooled{id: constructed.Inc(), inUse: NewAtomicCounter()}
+	}
+}
+
+func TestPoolReuse(t *testing.T) {
+	constructed := NewAtomicCounter()
+	p := NewPool(counting(constructed))
+	assert.Equal(t, DefaultPoolSize, p.Cap())
+	assert.Equal(t, 0, p.Len())
+
+	first := p.Get().(*pooled)
+	assert.Equal(t, int64(1), constructed.Get())
+	p.Put(first)
+	assert.Equal(t, 1, p.Len())
+
+	second := p.Get().(*pooled)
+	assert.Same(t, first, second)
+	assert.Equal(t, int64(1), constructed.Get())
+	assert.Equal(t, 0, p.Len())
+
+	third := p.Get().(*pooled)
+	assert.NotSame(t, second, third)
+	assert.Equal(t, int64(2), constructed.Get())
+}
+
+func TestPoolMaxSize(t *testing.T) {
+	constructed := NewAtomicCounter()
+	p := NewPool(counting(constructed), 2)
+	assert.Equal(t, 2, p.Cap())
+
+	items := make([]interface{}, 3)
+	for i := range items {
+		items[i] = p.Get()
+	}
+	assert.Equal(t, int64(3), constructed.Get())
+
+	for _, item := range items {
+		p.Put(item)
+	}
+	assert.Equal(t, 2, p.Len())
+
+	// The two retained items are reused; the third was discarded, so a new one is constructed.
+	assert.Same(t, items[0], p.Get())
+	assert.Same(t, items[1], p.Get())
+	assert.Equal(t, int64(3), constructed.Get())
+	p.Get()
+	assert.Equal(t, int64(4), constructed.Get())
+}
+
+func TestPoolInvalidMaxSize(t *testing.T) {
+	check.ThatPanicsAsExpected(t, check.ErrorWithValue("max size must be greater than 0"), func() {
+		NewPool(counting(NewAtomicCounter()), 0)
+	})
+}
+
+func TestPoolString(t *testing.T) {
+	p := NewPool(counting(NewAtomicCounter()), 4)
+	p.Put(p.Get())
+	assert.Equal(t, "Pool[Len=1, Cap=4]", p.String())
+}
+
+func TestPoolConcurrentGetPut(t *testing.T) {
+	const goroutines = 16
+	const maxSize = 4
+	constructed := NewAtomicCounter()
+	p := NewPool(counting(constructed), maxSize)
+
+	check.Hammer(t, goroutines, 1000, func(_, _ int) {
+		item := p.Get().(*pooled)
+		// No item may be handed out to more than one goroutine at a time.
+		assert.Equal(t, int64(1), item.inUse.Inc())
+		assert.Equal(t, int64(0), item.inUse.Dec())
+		p.Put(item)
+	})
+
+	assert.LessOrEqual(t, p.Len(), maxSize)
+	assert.LessOrEqual(t, constructed.Get(), int64(goroutines*1000))
+	assert.Greater(t, constructed.Get(), int64(0))
+}
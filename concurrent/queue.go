@@ -0,0 +1,101 @@
+package concurrent
+
+import (
+	"context"
+	"fmt"
+)
+
+// BlockingQueue is a bounded, thread-safe FIFO queue. Put blocks while the queue is full, and Take blocks while it is
+// empty; both may be abandoned by cancelling the supplied context. TryPut and TryTake are the non-blocking
+// counterparts.
+type BlockingQueue interface {
+	fmt.Stringer
+	Put(ctx context.Context, item interface{}) error
+	Take(ctx context.Context) (interface{}, error)
+	TryPut(item interface{}) bool
+	TryTake() (interface{}, bool)
+	Len() int
+	Cap() int
+}
+
+type blockingQueue struct {
+	items  chan interface{}
+	length AtomicCounter
+}
+
+// NewBlockingQueue creates a new queue that holds at most capacity items. The capacity must be greater than 0.
+func NewBlockingQueue(capacity int) BlockingQueue {
+	if capacity <= 0 {
+		panic(fmt.Errorf("capacity must be greater than 0"))
+	}
+	return &blockingQueue{
+		items:  make(chan interface{}, capacity),
+		length: NewAtomicCounter(),
+	}
+}
+
+// String obtains a string representation of the queue.
+func (q *blockingQueue) String() string {
+	return fmt.Sprint("BlockingQueue[Len=", q.Len(), ", Cap=", q.Cap(), "]")
+}
+
+// Put appends an item to the tail of the queue, blocking while the queue is full. If the context is cancelled
+// before the item can be added, the context's error is returned.
+func (q *blockingQueue) Put(ctx context.Context, item interface{}) error {
+	select {
+	case q.items <- item:
+		q.length.Inc()
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Take removes an item from the head of the queue, blocking while the queue is empty. If the context is cancelled
+// before an item becomes available, the context's error is returned.
+func (q *blockingQueue) Take(ctx context.Context) (interface{}, error) {
+	select {
+	case item := <-q.items:
+		q.length.Dec()
+		return item, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// TryPut appends an item to the tail of the queue if there is space, returning true if the item was added.
+func (q *blockingQueue) TryPut(item interface{}) bool {
+	select {
+	case q.items <- item:
+		q.length.Inc()
+		return true
+	default:
+		return false
+	}
+}
+
+// TryTake removes an item from the head of the queue if one is available, returning the item and true, or nil and
+// false if the queue is empty.
+func (q *blockingQueue) TryTake() (interface{}, bool) {
+	select {
+	case item := <-q.items:
+		q.length.Dec()
+		return item, true
+	default:
+		return nil, false
+	}
+}
+
+// Len returns the number of items in the queue. The length is tracked with an AtomicCounter that is updated after
+// each item is added or removed; under concurrent use, it is an approximation suitable for monitoring.
+func (q *blockingQueue) Len() int {
+	if length := q.length.GetInt(); length > 0 {
+		return length
+	}
+	return 0
+}
+
+// Cap returns the capacity of the queue.
+func (q *blockingQueue) Cap() int {
+	return cap(q.items)
+}
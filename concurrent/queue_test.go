@@ -0,0 +1,101 @@
+package concurrent
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/obsidiandynamics/libstdgo/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBlockingQueue_invalidCapacity(t *testing.T) {
+	check.ThatPanicsAsExpected(t, check.ErrorWithValue("capacity must be greater than 0"), func() {
+		NewBlockingQueue(0)
+	})
+}
+
+func TestBlockingQueue_fifo(t *testing.T) {
+	q := NewBlockingQueue(3)
+	assert.Equal(t, 3, q.Cap())
+	assert.Equal(t, "BlockingQueue[Len=0, Cap=3]", q.String())
+
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, q.Put(context.Background(), i))
+	}
+	assert.Equal(t, 3, q.Len())
+
+	for i := 0; i < 3; i++ {
+		item, err := q.Take(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, i, item)
+	}
+	assert.Equal(t, 0, q.Len())
+}
+
+func TestBlockingQueue_tryPutAndTryTake(t *testing.T) {
+	q := NewBlockingQueue(1)
+	item, ok := q.TryTake()
+	assert.Nil(t, item)
+	assert.False(t, ok)
+
+	assert.True(t, q.TryPut("a"))
+	assert.False(t, q.TryPut("b"))
+	assert.Equal(t, 1, q.Len())
+
+	item, ok = q.TryTake()
+	assert.Equal(t, "a", item)
+	assert.True(t, ok)
+	assert.Equal(t, 0, q.Len())
+}
+
+func TestBlockingQueue_putBlocksWhenFull(t *testing.T) {
+	q := NewBlockingQueue(1)
+	assert.Nil(t, q.Put(context.Background(), "a"))
+
+	put := NewAtomicBool()
+	go func() {
+		assert.Nil(t, q.Put(context.Background(), "b"))
+		put.Set(true)
+	}()
+
+	assert.False(t, put.Await(true, time.Millisecond))
+	item, err := q.Take(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "a", item)
+	assert.True(t, put.Await(true, 10*time.Second))
+
+	item, err = q.Take(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "b", item)
+}
+
+func TestBlockingQueue_takeBlocksWhenEmpty(t *testing.T) {
+	q := NewBlockingQueue(1)
+
+	taken := NewAtomicReference()
+	go func() {
+		item, err := q.Take(context.Background())
+		assert.Nil(t, err)
+		taken.Set(item)
+	}()
+
+	time.Sleep(time.Millisecond)
+	assert.Nil(t, taken.Get())
+	assert.Nil(t, q.Put(context.Background(), "a"))
+	assert.Equal(t, "a", taken.Await(RefEqual("a"), 10*time.Second))
+}
+
+func TestBlockingQueue_contextCancellation(t *testing.T) {
+	q := NewBlockingQueue(1)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	item, err := q.Take(ctx)
+	assert.Nil(t, item)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	assert.Nil(t, q.Put(context.Background(), "a"))
+	assert.Equal(t, context.DeadlineExceeded, q.Put(ctx, "b"))
+	assert.Equal(t, 1, q.Len())
+}
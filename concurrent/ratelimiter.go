@@ -0,0 +1,115 @@
+package concurrent
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// RateLimiter is a token bucket that admits operations at a sustained rate, while allowing for short bursts up to
+// the capacity of the bucket.
+type RateLimiter interface {
+	fmt.Stringer
+	Allow() bool
+	AllowN(n int) bool
+	Wait(ctx context.Context) error
+}
+
+type rateLimiter struct {
+	rate     float64
+	burst    int
+	interval int64 // nanoseconds between successive tokens
+	start    time.Time
+	tat      int64 // theoretical arrival time, in nanoseconds since start
+}
+
+// NewRateLimiter creates a RateLimiter that refills at the given rate (in tokens per second), holding at most
+// burst tokens. The bucket is initially full.
+//
+// The limiter does not use a background goroutine; instead, the state of the bucket is derived from the elapsed
+// time whenever tokens are requested, and is updated atomically.
+func NewRateLimiter(rate float64, burst int) RateLimiter {
+	if rate <= 0 {
+		panic(fmt.Errorf("rate must be greater than 0"))
+	}
+	if burst <= 0 {
+		panic(fmt.Errorf("burst must be greater than 0"))
+	}
+	interval := int64(float64(time.Second) / rate)
+	if interval < 1 {
+		interval = 1
+	}
+	return &rateLimiter{
+		rate:     rate,
+		burst:    burst,
+		interval: interval,
+		start:    time.Now(),
+	}
+}
+
+// String obtains a string representation of the rate limiter.
+func (r *rateLimiter) String() string {
+	return fmt.Sprint("RateLimiter[Rate=", r.rate, ", Burst=", r.burst, "]")
+}
+
+func (r *rateLimiter) now() int64 {
+	return int64(time.Since(r.start))
+}
+
+// Allow takes a single token from the bucket, returning true if a token was available.
+func (r *rateLimiter) Allow() bool {
+	return r.AllowN(1)
+}
+
+// AllowN takes n tokens from the bucket, returning true if n tokens were available. Either all n tokens are taken,
+// or none are. Requesting more tokens than the burst size will always fail.
+func (r *rateLimiter) AllowN(n int) bool {
+	_, ok := r.take(n)
+	return ok
+}
+
+// Attempts to take n tokens. If successful, returns (0, true); otherwise returns the time that the caller should
+// wait before the tokens become available.
+func (r *rateLimiter) take(n int) (time.Duration, bool) {
+	if n <= 0 {
+		return 0, true
+	}
+	cost := int64(n) * r.interval
+	limit := int64(r.burst) * r.interval
+	for {
+		now := r.now()
+		observed := atomic.LoadInt64(&r.tat)
+		tat := observed
+		if tat < now {
+			tat = now
+		}
+		newTat := tat + cost
+		if excess := newTat - now - limit; excess > 0 {
+			return time.Duration(excess), false
+		}
+		if atomic.CompareAndSwapInt64(&r.tat, observed, newTat) {
+			return 0, true
+		}
+	}
+}
+
+// Wait blocks until a single token can be taken from the bucket, or until the context expires, in which case the
+// context's error is returned.
+func (r *rateLimiter) Wait(ctx context.Context) error {
+	for {
+		delay, ok := r.take(1)
+		if ok {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+			Nop()
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
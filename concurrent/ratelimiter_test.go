@@ -0,0 +1,86 @@
+package concurrent
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/obsidiandynamics/libstdgo/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRateLimiter_invalid(t *testing.T) {
+	check.ThatPanicsAsExpected(t, check.ErrorContaining("rate must be greater than 0"), func() {
+		NewRateLimiter(0, 1)
+	})
+	check.ThatPanicsAsExpected(t, check.ErrorContaining("burst must be greater than 0"), func() {
+		NewRateLimiter(1, 0)
+	})
+}
+
+func TestRateLimiter_burst(t *testing.T) {
+	r := NewRateLimiter(0.001, 3)
+	assert.Equal(t, "RateLimiter[Rate=0.001, Burst=3]", r.String())
+
+	assert.True(t, r.AllowN(0))
+	assert.True(t, r.Allow())
+	assert.True(t, r.AllowN(2))
+	assert.False(t, r.Allow())
+}
+
+func TestRateLimiter_excessiveN(t *testing.T) {
+	r := NewRateLimiter(0.001, 3)
+	assert.False(t, r.AllowN(4))
+
+	// A failed request doesn't consume any tokens.
+	assert.True(t, r.AllowN(3))
+}
+
+func TestRateLimiter_concurrentBurst(t *testing.T) {
+	const burst = 100
+	r := NewRateLimiter(0.001, burst)
+	allowed := NewAtomicCounter()
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < burst; j++ {
+				if r.Allow() {
+					allowed.Inc()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int64(burst), allowed.Get())
+}
+
+func TestRateLimiter_refill(t *testing.T) {
+	r := NewRateLimiter(1000, 1)
+	assert.True(t, r.Allow())
+	assert.False(t, r.Allow())
+
+	check.Wait(t, 10*time.Second).Until(r.Allow)
+}
+
+func TestRateLimiter_wait(t *testing.T) {
+	r := NewRateLimiter(100, 1)
+	assert.True(t, r.Allow())
+
+	started := time.Now()
+	assert.Nil(t, r.Wait(context.Background()))
+	assert.GreaterOrEqual(t, int64(time.Since(started)), int64(5*time.Millisecond))
+	assert.False(t, r.Allow())
+}
+
+func TestRateLimiter_waitCancelled(t *testing.T) {
+	r := NewRateLimiter(0.001, 1)
+	assert.True(t, r.Allow())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, r.Wait(ctx))
+}
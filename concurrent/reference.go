@@ -3,6 +3,7 @@ package concurrent
 import (
 	"context"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 type AtomicReference interface {
 	fmt.Stringer
 	Set(value interface{})
+	Swap(value interface{}) interface{}
 	Get() interface{}
 	Await(cond RefCondition, timeout time.Duration, interval ...time.Duration) interface{}
 	AwaitCtx(ctx context.Context, cond RefCondition, interval ...time.Duration) interface{}
@@ -26,28 +28,45 @@ type pointer struct {
 type atomicReference struct {
 	notify chan int
 	value  atomic.Value
+	// Serialises writers, so that Swap observes the referent it replaces. Readers are lock-free.
+	lock sync.Mutex
 }
 
 // NewAtomicReference creates a new reference, optionally assigning its contents to the given
 // initial referent (nil by default)
 func NewAtomicReference(initial ...interface{}) AtomicReference {
-	v := atomicReference{
+	v := &atomicReference{
 		notify: make(chan int, 1),
-		value:  atomic.Value{},
 	}
 	initVal := arity.SoleUntyped(nil, initial)
 	v.value.Store(pointer{initVal})
-	return &v
+	return v
 }
 
 // String obtains a string representation of the atomic reference, printing the underlying referent.
-func (v atomicReference) String() string {
+func (v *atomicReference) String() string {
 	return fmt.Sprint(v.Get())
 }
 
 // Sets a new referent.
 func (v *atomicReference) Set(referent interface{}) {
+	v.lock.Lock()
 	v.value.Store(pointer{referent})
+	v.lock.Unlock()
+	v.notifyUpdate()
+}
+
+// Swap atomically sets a new referent, returning the previous one.
+func (v *atomicReference) Swap(referent interface{}) interface{} {
+	v.lock.Lock()
+	previous := v.Get()
+	v.value.Store(pointer{referent})
+	v.lock.Unlock()
+	v.notifyUpdate()
+	return previous
+}
+
+func (v *atomicReference) notifyUpdate() {
 	select {
 	case v.notify <- 0:
 		Nop()
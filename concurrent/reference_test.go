@@ -109,3 +109,43 @@ func TestAtomicReference_Stringer(t *testing.T) {
 		}
 	}
 }
+
+func TestAtomicReference_Swap(t *testing.T) {
+	v := NewAtomicReference("a")
+	assert.Equal(t, "a", v.Swap("b"))
+	assert.Equal(t, "b", v.Get())
+	assert.Equal(t, "b", v.Swap(nil))
+	assert.Nil(t, v.Swap("c"))
+}
+
+func TestAtomicReference_SwapNotifiesWaiters(t *testing.T) {
+	v := NewAtomicReference()
+	awaited := NewAtomicReference()
+	go func() {
+		awaited.Set(v.Await(RefEqual("x"), 10*time.Second, Indefinitely))
+	}()
+
+	time.Sleep(time.Millisecond)
+	assert.Nil(t, v.Swap("x"))
+	assert.Equal(t, "x", awaited.Await(RefNot(RefNil()), 10*time.Second))
+}
+
+func TestAtomicReference_SwapConcurrent(t *testing.T) {
+	v := NewAtomicReference(0)
+	const goroutines, iterations = 4, 1000
+	seen := make([][]interface{}, goroutines)
+	check.Hammer(t, goroutines, iterations, func(g, i int) {
+		seen[g] = append(seen[g], v.Swap(g*iterations+i+1))
+	})
+
+	// Every value, bar the last one stored, is returned by exactly one Swap.
+	returned := map[interface{}]bool{}
+	for _, values := range seen {
+		for _, value := range values {
+			assert.False(t, returned[value], "value %v returned twice", value)
+			returned[value] = true
+		}
+	}
+	assert.Len(t, returned, goroutines*iterations)
+	assert.False(t, returned[v.Get()])
+}
@@ -36,6 +36,38 @@ func (s *shard) add(key string, amount int64) int64 {
 	return updated
 }
 
+func (s *shard) addAndCheck(key string, amount int64, threshold int64) (int64, bool) {
+	defer s.notifyUpdate()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	existing := s.counters[key]
+	updated := existing + amount
+	if updated == 0 {
+		delete(s.counters, key)
+	} else {
+		s.counters[key] = updated
+	}
+	return updated, existing < threshold && updated >= threshold
+}
+
+func (s *shard) addBounded(key string, amount int64, ceiling int64) (int64, bool) {
+	s.lock.Lock()
+	existing := s.counters[key]
+	updated := existing + amount
+	if updated > ceiling {
+		s.lock.Unlock()
+		return existing, false
+	}
+	if updated == 0 {
+		delete(s.counters, key)
+	} else {
+		s.counters[key] = updated
+	}
+	s.lock.Unlock()
+	s.notifyUpdate()
+	return updated, true
+}
+
 func (s *shard) set(key string, amount int64) {
 	defer s.notifyUpdate()
 	s.lock.Lock()
@@ -47,6 +79,22 @@ func (s *shard) set(key string, amount int64) {
 	}
 }
 
+func (s *shard) compareAndSet(key string, expected int64, replacement int64) bool {
+	s.lock.Lock()
+	if s.counters[key] != expected {
+		s.lock.Unlock()
+		return false
+	}
+	if replacement == 0 {
+		delete(s.counters, key)
+	} else {
+		s.counters[key] = replacement
+	}
+	s.lock.Unlock()
+	s.notifyUpdate()
+	return true
+}
+
 func (s *shard) notifyUpdate() {
 	select {
 	case s.notify <- 0:
@@ -76,13 +124,13 @@ func (s *shard) clear() {
 	s.counters = make(map[string]int64)
 }
 
-func (s *shard) await(ctx context.Context, key string, cond I64Condition, interval ...time.Duration) int64 {
+func (s *shard) await(ctx context.Context, key string, cond I64Condition, interval ...time.Duration) (int64, bool) {
 	checkInterval := optional(DefaultScoreboardCheckInterval, interval...)
 	var sleepTicker *time.Ticker
 	for {
 		value := s.get(key)
 		if cond(value) {
-			return value
+			return value, true
 		}
 
 		if sleepTicker == nil {
@@ -92,7 +140,7 @@ func (s *shard) await(ctx context.Context, key string, cond I64Condition, interv
 
 		select {
 		case <-ctx.Done():
-			return value
+			return value, false
 		case <-s.notify:
 			Nop()
 		case <-sleepTicker.C:
@@ -108,15 +156,20 @@ type Scoreboard interface {
 	Add(key string, amount int64) int64
 	Inc(key string) int64
 	Dec(key string) int64
+	IncAndCheck(key string, threshold int64) (int64, bool)
+	AddBounded(key string, amount int64, ceiling int64) (int64, bool)
 	Get(key string) int64
 	GetInt(key string) int
 	Set(key string, value int64)
+	CompareAndSet(key string, expected int64, replacement int64) bool
+	Merge(other Scoreboard)
 	Clear()
 	View() map[string]int64
 	Fill(key string, atLeast int64, timeout time.Duration, interval ...time.Duration) int64
 	Drain(key string, atMost int64, timeout time.Duration, interval ...time.Duration) int64
 	Await(key string, cond I64Condition, timeout time.Duration, interval ...time.Duration) int64
 	AwaitCtx(ctx context.Context, key string, cond I64Condition, interval ...time.Duration) int64
+	AwaitCtxResult(ctx context.Context, key string, cond I64Condition, interval ...time.Duration) (int64, bool)
 }
 
 type scoreboard struct {
@@ -163,6 +216,22 @@ func (b *scoreboard) Dec(key string) int64 {
 	return b.Add(key, -1)
 }
 
+// IncAndCheck increments the score for the given key, returning the updated value and a flag indicating whether
+// this increment caused the score to cross the given threshold — transitioning from below the threshold to at or
+// above it. The crossing is evaluated atomically; among concurrent increments, exactly one will observe it.
+func (b *scoreboard) IncAndCheck(key string, threshold int64) (int64, bool) {
+	return b.forKey(key).addAndCheck(key, 1, threshold)
+}
+
+// AddBounded adds a specified amount to the score for the given key, provided that the updated value would not
+// exceed the given ceiling, returning the resulting value and a flag indicating whether the addition was accepted.
+// If rejected, the score is left unchanged and its existing value is returned. The check and the addition are
+// performed atomically, so concurrent callers can never collectively push the score past the ceiling. This is
+// useful for enforcing quotas.
+func (b *scoreboard) AddBounded(key string, amount int64, ceiling int64) (int64, bool) {
+	return b.forKey(key).addBounded(key, amount, ceiling)
+}
+
 // Gets the current score for the given key.
 func (b *scoreboard) Get(key string) int64 {
 	return b.forKey(key).get(key)
@@ -178,6 +247,21 @@ func (b *scoreboard) Set(key string, value int64) {
 	b.forKey(key).set(key, value)
 }
 
+// CompareAndSet conditionally assigns a replacement score for the given key if the existing score matches the
+// expected value, returning true if the replacement took place.
+func (b *scoreboard) CompareAndSet(key string, expected int64, replacement int64) bool {
+	return b.forKey(key).compareAndSet(key, expected, replacement)
+}
+
+// Merge adds the score of each key in other to the corresponding score in this scoreboard. The scores of other are
+// taken as a snapshot (each of its shards being read under lock), and are applied to this scoreboard key-by-key;
+// subsequent changes to other are not reflected. Merging a scoreboard into itself doubles each score.
+func (b *scoreboard) Merge(other Scoreboard) {
+	for key, value := range other.View() {
+		b.Add(key, value)
+	}
+}
+
 // Clear purges the contents of this scoreboard.
 func (b *scoreboard) Clear() {
 	for _, shard := range b.shards {
@@ -228,5 +312,13 @@ func (b *scoreboard) Await(key string, cond I64Condition, timeout time.Duration,
 // Await blocks until a condition is met or the context is cancelled, returning the last observed score.
 // The optional interval argument places an upper bound on the check interval (defaults to DefaultScoreboardCheckInterval).
 func (b *scoreboard) AwaitCtx(ctx context.Context, key string, cond I64Condition, interval ...time.Duration) int64 {
+	value, _ := b.AwaitCtxResult(ctx, key, cond, interval...)
+	return value
+}
+
+// AwaitCtxResult is a variant of AwaitCtx that additionally returns whether the condition was satisfied, as opposed
+// to the context having been cancelled (or having timed out) first. This distinguishes a genuinely satisfied score
+// from one that was merely the last observed before giving up — for example, when awaiting a score of zero.
+func (b *scoreboard) AwaitCtxResult(ctx context.Context, key string, cond I64Condition, interval ...time.Duration) (int64, bool) {
 	return b.forKey(key).await(ctx, key, cond, interval...)
 }
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/obsidiandynamics/libstdgo/check"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -85,6 +86,46 @@ func TestScoreboardAwaitCtxCancel(t *testing.T) {
 	assert.Equal(t, int64(1), res)
 }
 
+func TestScoreboardAwaitCtxResult_satisfied(t *testing.T) {
+	b := NewScoreboard()
+	b.Set(defKey, 1)
+	go func() {
+		time.Sleep(1 * time.Millisecond)
+		b.Dec(defKey)
+	}()
+
+	ctx, cancel := Forever(context.Background())
+	defer cancel()
+	value, satisfied := b.AwaitCtxResult(ctx, defKey, I64Equal(0), 1*time.Hour)
+	assert.Equal(t, int64(0), value)
+	assert.True(t, satisfied)
+}
+
+func TestScoreboardAwaitCtxResult_cancelled(t *testing.T) {
+	b := NewScoreboard()
+	ctx, cancel := Forever(context.Background())
+	go func() {
+		time.Sleep(1 * time.Millisecond)
+		cancel()
+	}()
+
+	defer cancel()
+	value, satisfied := b.AwaitCtxResult(ctx, defKey, I64Equal(1), 1*time.Hour)
+	assert.Equal(t, int64(0), value)
+	assert.False(t, satisfied)
+}
+
+func TestScoreboardAwaitCtxResult_alreadySatisfied(t *testing.T) {
+	b := NewScoreboard()
+	ctx, cancel := Forever(context.Background())
+	cancel()
+
+	// A satisfied condition is reported as such, even if the context has already been cancelled.
+	value, satisfied := b.AwaitCtxResult(ctx, defKey, I64Equal(0))
+	assert.Equal(t, int64(0), value)
+	assert.True(t, satisfied)
+}
+
 func TestScoreboardAwaitCtxInDeepSleep(t *testing.T) {
 	b := NewScoreboard(1)
 	b.Set(defKey, 1)
@@ -172,6 +213,142 @@ func TestScoreboardSet(t *testing.T) {
 	assert.Equal(t, 7, b.GetInt(defKey))
 }
 
+func TestScoreboardCompareAndSet(t *testing.T) {
+	b := NewScoreboard()
+
+	// Matching against an absent key, which is implicitly zero.
+	assert.True(t, b.CompareAndSet(defKey, 0, 42))
+	assert.Equal(t, int64(42), b.Get(defKey))
+
+	// Non-matching.
+	assert.False(t, b.CompareAndSet(defKey, 41, 43))
+	assert.Equal(t, int64(42), b.Get(defKey))
+
+	// Matching.
+	assert.True(t, b.CompareAndSet(defKey, 42, 43))
+	assert.Equal(t, int64(43), b.Get(defKey))
+
+	// Replacement to zero removes the key.
+	assert.True(t, b.CompareAndSet(defKey, 43, 0))
+	assert.Equal(t, map[string]int64{}, b.View())
+}
+
+func TestScoreboardCompareAndSet_notifiesWaiter(t *testing.T) {
+	b := NewScoreboard(1)
+	b.Set(defKey, 1)
+	go func() {
+		time.Sleep(1 * time.Millisecond)
+		assert.True(t, b.CompareAndSet(defKey, 1, 2))
+	}()
+
+	res := b.Fill(defKey, 2, Indefinitely, 1*time.Hour)
+	assert.Equal(t, int64(2), res)
+}
+
+func TestScoreboardIncAndCheck(t *testing.T) {
+	b := NewScoreboard()
+	value, crossed := b.IncAndCheck(defKey, 2)
+	assert.Equal(t, int64(1), value)
+	assert.False(t, crossed)
+
+	value, crossed = b.IncAndCheck(defKey, 2)
+	assert.Equal(t, int64(2), value)
+	assert.True(t, crossed)
+
+	value, crossed = b.IncAndCheck(defKey, 2)
+	assert.Equal(t, int64(3), value)
+	assert.False(t, crossed)
+
+	// Dropping below the threshold allows it to be crossed again.
+	b.Set(defKey, 1)
+	value, crossed = b.IncAndCheck(defKey, 2)
+	assert.Equal(t, int64(2), value)
+	assert.True(t, crossed)
+
+	// Crossing into zero removes the key.
+	b.Set(defKey, -1)
+	value, crossed = b.IncAndCheck(defKey, 0)
+	assert.Equal(t, int64(0), value)
+	assert.True(t, crossed)
+	assert.Empty(t, b.View())
+}
+
+func TestScoreboardIncAndCheck_concurrent(t *testing.T) {
+	b := NewScoreboard()
+	const goroutines = 16
+	const incrementsPerGoroutine = 100
+	const threshold = goroutines * incrementsPerGoroutine / 2
+
+	crossings := NewAtomicCounter()
+	wg := sync.WaitGroup{}
+	wg.Add(goroutines)
+	for i := 0; i < goroutines; i++ {
+		go func() {
+			defer wg.Done()
+			for j := 0; j < incrementsPerGoroutine; j++ {
+				if _, crossed := b.IncAndCheck(defKey, threshold); crossed {
+					crossings.Inc()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int64(1), crossings.Get())
+	assert.Equal(t, int64(goroutines*incrementsPerGoroutine), b.Get(defKey))
+}
+
+func TestScoreboardAddBounded(t *testing.T) {
+	b := NewScoreboard()
+	value, accepted := b.AddBounded(defKey, 3, 5)
+	assert.Equal(t, int64(3), value)
+	assert.True(t, accepted)
+
+	value, accepted = b.AddBounded(defKey, 2, 5)
+	assert.Equal(t, int64(5), value)
+	assert.True(t, accepted)
+
+	// Exceeding the ceiling leaves the score unchanged.
+	value, accepted = b.AddBounded(defKey, 1, 5)
+	assert.Equal(t, int64(5), value)
+	assert.False(t, accepted)
+	assert.Equal(t, int64(5), b.Get(defKey))
+
+	// Subtractions are accepted, and may remove the key.
+	value, accepted = b.AddBounded(defKey, -5, 5)
+	assert.Equal(t, int64(0), value)
+	assert.True(t, accepted)
+	assert.Empty(t, b.View())
+
+	// A rejected addition does not create a key.
+	value, accepted = b.AddBounded(defKey, 6, 5)
+	assert.Equal(t, int64(0), value)
+	assert.False(t, accepted)
+	assert.Empty(t, b.View())
+}
+
+func TestScoreboardAddBounded_concurrent(t *testing.T) {
+	b := NewScoreboard()
+	const goroutines = 16
+	const additionsPerGoroutine = 100
+	const ceiling = goroutines * additionsPerGoroutine
+
+	accepted, rejected := NewAtomicCounter(), NewAtomicCounter()
+	check.Hammer(t, goroutines, additionsPerGoroutine, func(_, _ int) {
+		value, ok := b.AddBounded(defKey, 3, ceiling)
+		assert.LessOrEqual(t, value, int64(ceiling))
+		if ok {
+			accepted.Inc()
+		} else {
+			rejected.Inc()
+		}
+	})
+
+	assert.Equal(t, int64(ceiling/3), accepted.Get())
+	assert.Equal(t, int64(goroutines*additionsPerGoroutine-ceiling/3), rejected.Get())
+	assert.Equal(t, int64(ceiling/3*3), b.Get(defKey))
+}
+
 func TestScoreboardClear(t *testing.T) {
 	b := NewScoreboard(3)
 	b.Set(defKey, 7)
@@ -184,3 +361,26 @@ func TestScoreboardStringer(t *testing.T) {
 	b.Set(defKey, 1)
 	assert.Equal(t, "Scoreboard[map[key:1]]", b.String())
 }
+
+func TestScoreboardMerge(t *testing.T) {
+	b0 := NewScoreboard()
+	b0.Add("a", 1)
+	b0.Add("b", 2)
+
+	b1 := NewScoreboard(4)
+	b1.Add("b", 3)
+	b1.Add("c", -4)
+
+	b0.Merge(b1)
+	assert.Equal(t, map[string]int64{"a": 1, "b": 5, "c": -4}, b0.View())
+	assert.Equal(t, map[string]int64{"b": 3, "c": -4}, b1.View())
+
+	// Keys that sum to zero are dropped.
+	b2 := NewScoreboard()
+	b2.Add("c", 4)
+	b0.Merge(b2)
+	assert.Equal(t, map[string]int64{"a": 1, "b": 5}, b0.View())
+
+	b0.Merge(b0)
+	assert.Equal(t, map[string]int64{"a": 2, "b": 10}, b0.View())
+}
@@ -0,0 +1,91 @@
+package concurrent
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/obsidiandynamics/libstdgo/arity"
+)
+
+// SingleFlight deduplicates concurrent work on the same key: when multiple goroutines call Do with the same key
+// concurrently, only one of them runs the function, and the remainder wait for and share its result. This provides
+// protection against a thundering herd — for example, when a popular cache entry expires.
+type SingleFlight interface {
+	Do(key string, fn func() (interface{}, error)) (interface{}, error, bool)
+}
+
+type flight struct {
+	wg     sync.WaitGroup
+	value  interface{}
+	err    error
+	shared bool
+}
+
+type flightShard struct {
+	lock    sync.Mutex
+	flights map[string]*flight
+}
+
+type singleFlight struct {
+	shards []*flightShard
+}
+
+// NewSingleFlight creates a new SingleFlight instance with an optionally specified concurrency level, controlling
+// the number of internal shards. If unspecified, concurrency is set to DefaultConcurrency.
+//
+// As with a Scoreboard, each shard is individually locked, allowing for a greater degree of uncontended access
+// across keys.
+func NewSingleFlight(concurrency ...int) SingleFlight {
+	conc := arity.SoleUntyped(DefaultConcurrency, concurrency).(int)
+	s := &singleFlight{
+		shards: make([]*flightShard, conc),
+	}
+	for i := 0; i < conc; i++ {
+		s.shards[i] = &flightShard{flights: make(map[string]*flight)}
+	}
+	return s
+}
+
+// Do runs the given function for the key, unless a call for the same key is already in flight, in which case it
+// waits for the in-flight call to complete and returns its result. The returned bool is true if the result was
+// shared among multiple callers (including the caller that ran the function).
+//
+// If the function panics, the panic propagates to the caller that ran it, while the waiting callers receive an error.
+func (s *singleFlight) Do(key string, fn func() (interface{}, error)) (value interface{}, err error, shared bool) {
+	shard := s.shards[hash(key)%uint32(len(s.shards))]
+	shard.lock.Lock()
+	if f, ok := shard.flights[key]; ok {
+		f.shared = true
+		shard.lock.Unlock()
+		f.wg.Wait()
+		return f.value, f.err, true
+	}
+
+	f := &flight{}
+	f.wg.Add(1)
+	shard.flights[key] = f
+	shard.lock.Unlock()
+
+	completed := false
+	defer func() {
+		var cause interface{}
+		if !completed {
+			cause = recover()
+			f.err = fmt.Errorf("in-flight call for key '%s' panicked: %v", key, cause)
+		}
+
+		shard.lock.Lock()
+		delete(shard.flights, key)
+		shared = f.shared
+		shard.lock.Unlock()
+		f.wg.Done()
+
+		if !completed {
+			panic(cause)
+		}
+	}()
+
+	f.value, f.err = fn()
+	completed = true
+	return f.value, f.err, false
+}
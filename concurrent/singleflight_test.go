@@ -0,0 +1,120 @@
+package concurrent
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/obsidiandynamics/libstdgo/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSingleFlight_sequential(t *testing.T) {
+	s := NewSingleFlight()
+	runs := 0
+	for i := 0; i < 3; i++ {
+		value, err, shared := s.Do("key", func() (interface{}, error) {
+			runs++
+			return runs, nil
+		})
+		assert.Equal(t, i+1, value)
+		assert.Nil(t, err)
+		assert.False(t, shared)
+	}
+}
+
+func TestSingleFlight_concurrent(t *testing.T) {
+	const callers = 8
+	s := NewSingleFlight()
+	runs := NewAtomicCounter()
+	release := make(chan struct{})
+
+	started := NewAtomicCounter()
+	wg := sync.WaitGroup{}
+	wg.Add(callers)
+	results := make([]interface{}, callers)
+	shares := NewAtomicCounter()
+	for i := 0; i < callers; i++ {
+		go func(i int) {
+			defer wg.Done()
+			started.Inc()
+			value, err, shared := s.Do("key", func() (interface{}, error) {
+				runs.Inc()
+				<-release
+				return "value", nil
+			})
+			assert.Nil(t, err)
+			results[i] = value
+			if shared {
+				shares.Inc()
+			}
+		}(i)
+	}
+
+	started.Fill(callers, 10*time.Second)
+	runs.Fill(1, 10*time.Second)
+	// Give the remaining callers a chance to join the in-flight call before releasing it.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int64(1), runs.Get())
+	for _, value := range results {
+		assert.Equal(t, "value", value)
+	}
+	assert.Equal(t, int64(callers), shares.Get())
+}
+
+func TestSingleFlight_distinctKeys(t *testing.T) {
+	s := NewSingleFlight(1)
+	release := make(chan struct{})
+	go s.Do("a", func() (interface{}, error) {
+		<-release
+		return nil, nil
+	})
+	defer close(release)
+
+	value, err, shared := s.Do("b", func() (interface{}, error) {
+		return "b", errors.New("b failed")
+	})
+	assert.Equal(t, "b", value)
+	assert.Equal(t, errors.New("b failed"), err)
+	assert.False(t, shared)
+}
+
+func TestSingleFlight_panic(t *testing.T) {
+	s := NewSingleFlight()
+	entered := make(chan struct{})
+	release := make(chan struct{})
+
+	waiterErr := make(chan error, 1)
+	go func() {
+		<-entered
+		go func() {
+			_, err, _ := s.Do("key", func() (interface{}, error) {
+				return "unexpected", nil
+			})
+			waiterErr <- err
+		}()
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+	}()
+
+	check.ThatPanicsAsExpected(t, check.CauseEqual("boom"), func() {
+		s.Do("key", func() (interface{}, error) {
+			close(entered)
+			<-release
+			panic("boom")
+		})
+	})
+
+	select {
+	case err := <-waiterErr:
+		if err != nil {
+			assert.Equal(t, "in-flight call for key 'key' panicked: boom", err.Error())
+		}
+	case <-time.After(10 * time.Second):
+		assert.Fail(t, "Timed out waiting for waiter")
+	}
+}
@@ -0,0 +1,40 @@
+package concurrent
+
+import "time"
+
+// Throttle limits the rate at which a function is run, collapsing rapid calls into the leading one. The first call
+// runs immediately; subsequent calls are dropped until the interval has elapsed since the last run.
+//
+// Throttle is thread-safe.
+type Throttle interface {
+	Call(f func()) bool
+	Stop()
+}
+
+type throttle struct {
+	deadline Deadline
+	stopped  AtomicBool
+}
+
+// NewThrottle creates a new Throttle with the specified interval.
+func NewThrottle(interval time.Duration) Throttle {
+	return &throttle{
+		deadline: NewDeadline(interval),
+		stopped:  NewAtomicBool(),
+	}
+}
+
+// Call runs the given function if the throttle interval has elapsed since the last run, returning true if the
+// function was run. Calls that arrive within the interval are dropped. Once the throttle has been stopped, no further
+// calls are run.
+func (t *throttle) Call(f func()) bool {
+	if t.stopped.Get() {
+		return false
+	}
+	return t.deadline.TryRun(f)
+}
+
+// Stop the throttle, causing all subsequent calls to be dropped.
+func (t *throttle) Stop() {
+	t.stopped.Set(true)
+}
@@ -0,0 +1,41 @@
+package concurrent
+
+import (
+	"testing"
+	"time"
+
+	"github.com/obsidiandynamics/libstdgo/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThrottle(t *testing.T) {
+	th := NewThrottle(time.Hour)
+	runs := NewAtomicCounter()
+
+	assert.True(t, th.Call(func() { runs.Inc() }))
+	assert.Equal(t, int64(1), runs.Get())
+
+	for i := 0; i < 10; i++ {
+		assert.False(t, th.Call(func() { runs.Inc() }))
+	}
+	assert.Equal(t, int64(1), runs.Get())
+}
+
+func TestThrottle_afterInterval(t *testing.T) {
+	th := NewThrottle(time.Millisecond)
+	runs := NewAtomicCounter()
+
+	assert.True(t, th.Call(func() { runs.Inc() }))
+	check.Wait(t, 10*time.Second).Until(func() bool {
+		return th.Call(func() { runs.Inc() })
+	})
+	assert.Equal(t, int64(2), runs.Get())
+}
+
+func TestThrottle_stop(t *testing.T) {
+	th := NewThrottle(0)
+	th.Stop()
+	assert.False(t, th.Call(func() {
+		assert.Fail(t, "Unexpected call")
+	}))
+}
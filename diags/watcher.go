@@ -1,6 +1,9 @@
 package diags
 
 import (
+	"fmt"
+	"runtime"
+	"strings"
 	"time"
 
 	"github.com/obsidiandynamics/libstdgo/concurrent"
@@ -13,6 +16,24 @@ type Watcher struct {
 	duration  time.Duration
 	timer     *time.Timer
 	done      chan int
+	stack     []uintptr
+}
+
+// WatchOption configures the behaviour of a Watcher. Options are supplied to Watch.
+type WatchOption func(w *Watcher)
+
+// Maximum number of stack frames retained by CaptureStack.
+const maxStackDepth = 32
+
+// CaptureStack causes the Watcher to capture the caller's stack at the point when Watch is invoked, making it
+// available via StartStack. Capturing is opt-in, as it adds overhead to every Watch call.
+func CaptureStack() WatchOption {
+	return func(w *Watcher) {
+		pcs := make([]uintptr, maxStackDepth)
+		// Skip runtime.Callers, this closure and Watch.
+		n := runtime.Callers(3, pcs)
+		w.stack = pcs[:n]
+	}
 }
 
 // Trigger is a function that is fired when a deadline is missed.
@@ -25,14 +46,46 @@ func Print(logger scribe.Logger) Trigger {
 	}
 }
 
+// PrintWithStack is a trigger function that will emit a message to the given printf-style logger, including
+// the stack of the caller that initiated the watch. The stack is only available if the Watcher was created with
+// the CaptureStack option.
+func PrintWithStack(logger scribe.Logger) Trigger {
+	return func(watcher *Watcher) {
+		logger("Operation '%s' took longer than %v; started at:\n%s",
+			watcher.operation, watcher.duration, FormatStack(watcher.stack))
+	}
+}
+
+// FormatStack renders a stack of program counters (as produced by runtime.Callers) in a form similar to a
+// goroutine stack dump, with one function per line, followed by an indented file and line number.
+func FormatStack(stack []uintptr) string {
+	if len(stack) == 0 {
+		return "<stack not captured>"
+	}
+
+	b := strings.Builder{}
+	frames := runtime.CallersFrames(stack)
+	for {
+		frame, more := frames.Next()
+		b.WriteString(fmt.Sprintf("%s\n\t%s:%d\n", frame.Function, frame.File, frame.Line))
+		if !more {
+			break
+		}
+	}
+	return b.String()
+}
+
 // Watch creates a Watcher that will fire the specified trigger when the deadline specified by the
 // duration argument expires, unless End() is called beforehand.
-func Watch(operation string, duration time.Duration, trigger Trigger) *Watcher {
+func Watch(operation string, duration time.Duration, trigger Trigger, opts ...WatchOption) *Watcher {
 	w := &Watcher{
 		operation: operation,
 		duration:  duration,
 		done:      make(chan int),
 	}
+	for _, opt := range opts {
+		opt(w)
+	}
 
 	go func() {
 		timer := time.NewTimer(duration)
@@ -53,3 +106,9 @@ func Watch(operation string, duration time.Duration, trigger Trigger) *Watcher {
 func (w *Watcher) End() {
 	close(w.done)
 }
+
+// StartStack returns the program counters of the stack captured when the Watcher was created, or nil if the
+// CaptureStack option was not specified.
+func (w *Watcher) StartStack() []uintptr {
+	return w.stack
+}
@@ -1,37 +1,39 @@
 package diags
 
 import (
+	"runtime"
 	"testing"
 	"time"
 
 	"github.com/obsidiandynamics/libstdgo/check"
-	"github.com/obsidiandynamics/libstdgo/concurrent"
 	"github.com/obsidiandynamics/libstdgo/scribe"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestWatch_ended(t *testing.T) {
-	triggered := concurrent.NewAtomicCounter()
+	triggered := check.NewSpy()
+	onTrigger := triggered.Func()
 	trigger := func(watcher *Watcher) {
-		triggered.Set(1)
+		onTrigger()
 	}
 
 	w := Watch("op", time.Hour, trigger)
 	defer w.End()
 	time.Sleep(1 * time.Millisecond)
-	assert.Equal(t, 0, triggered.GetInt())
+	triggered.AssertCalled(t, 0)
 }
 
 func TestWatch_triggered(t *testing.T) {
-	triggered := concurrent.NewAtomicCounter()
+	triggered := check.NewSpy()
+	onTrigger := triggered.Func()
 	trigger := func(watcher *Watcher) {
-		triggered.Set(1)
+		onTrigger()
 	}
 
 	w := Watch("op", time.Millisecond, trigger)
 	defer w.End()
 	check.Wait(t, 10*time.Second).UntilAsserted(func(t check.Tester) {
-		assert.Equal(t, 1, triggered.GetInt())
+		triggered.AssertCalled(t, 1)
 	})
 }
 
@@ -46,3 +48,35 @@ func TestPrint(t *testing.T) {
 		Having(scribe.MessageEqual("Operation 'op' took longer than 1ms")).
 		Passes(scribe.Count(1)))
 }
+
+func TestWatch_withoutStack(t *testing.T) {
+	w := Watch("op", time.Hour, func(*Watcher) {})
+	defer w.End()
+	assert.Nil(t, w.StartStack())
+	assert.Equal(t, "<stack not captured>", FormatStack(w.StartStack()))
+}
+
+func TestWatch_captureStack(t *testing.T) {
+	w := Watch("op", time.Hour, func(*Watcher) {}, CaptureStack())
+	defer w.End()
+	stack := w.StartStack()
+	if assert.NotEmpty(t, stack) {
+		first, _ := runtime.CallersFrames(stack).Next()
+		assert.Equal(t, "github.com/obsidiandynamics/libstdgo/diags.TestWatch_captureStack", first.Function)
+	}
+	assert.Contains(t, FormatStack(stack), "diags.TestWatch_captureStack\n\t")
+	assert.Contains(t, FormatStack(stack), "watcher_test.go:")
+}
+
+func TestPrintWithStack(t *testing.T) {
+	m := scribe.NewMock()
+	scr := scribe.New(m.Factories())
+
+	w := Watch("op", time.Millisecond, PrintWithStack(scr.W()), CaptureStack())
+	defer w.End()
+	check.Wait(t, 10*time.Second).UntilAsserted(m.ContainsEntries().
+		Having(scribe.LogLevel(scribe.Warn)).
+		Having(scribe.MessageContaining("Operation 'op' took longer than 1ms; started at:\n")).
+		Having(scribe.MessageContaining("diags.TestPrintWithStack")).
+		Passes(scribe.Count(1)))
+}
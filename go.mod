@@ -3,6 +3,7 @@ module github.com/obsidiandynamics/libstdgo
 go 1.14
 
 require (
+	github.com/apex/log v1.9.0
 	github.com/cihub/seelog v0.0.0-20170130134532-f561c5e57575
 	github.com/go-stack/stack v1.8.0
 	github.com/golang/glog v0.0.0-20160126235308-23def4e6c14b
@@ -13,7 +14,7 @@ require (
 	github.com/niemeyer/pretty v0.0.0-20200227124842-a10e7caefd8e // indirect
 	github.com/pkg/errors v0.9.1 // indirect
 	github.com/sirupsen/logrus v1.5.0
-	github.com/stretchr/testify v1.5.1
+	github.com/stretchr/testify v1.6.1
 	go.uber.org/zap v1.14.1
 	golang.org/x/lint v0.0.0-20200302205851-738671d3881b // indirect
 	golang.org/x/sys v0.0.0-20200413165638-669c56c373c4 // indirect
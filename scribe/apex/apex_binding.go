@@ -0,0 +1,68 @@
+// Package apex provides an apex/log binding for Scribe.
+package apex
+
+import (
+	"github.com/apex/log"
+	"github.com/obsidiandynamics/libstdgo/scribe"
+)
+
+func enrich(api log.Interface, scene scribe.Scene) log.Interface {
+	if fields := scene.AllFields(); len(fields) > 0 {
+		api = api.WithFields((log.Fields)(fields))
+	}
+	if scene.Err != nil {
+		api = api.WithError(scene.Err)
+	}
+	return api
+}
+
+// Determines whether the given level is enabled in the underlying logger. Where the level cannot be determined
+// (i.e. for a custom log.Interface implementation), the level is presumed enabled, deferring the decision to
+// the logger.
+func isLevelEnabled(api log.Interface, level log.Level) bool {
+	switch l := api.(type) {
+	case *log.Logger:
+		return level >= l.Level
+	case *log.Entry:
+		return l.Logger == nil || level >= l.Logger.Level
+	default:
+		return true
+	}
+}
+
+// Bind creates an apex/log binding for a given logger (which may be a *log.Logger or a *log.Entry). As apex/log
+// does not support a Trace level, Scribe's Trace is mapped to Debug.
+func Bind(logger log.Interface) scribe.LoggerFactories {
+	return scribe.LoggerFactories{
+		scribe.Trace: func(level scribe.Level, scene scribe.Scene) scribe.Logger {
+			if isLevelEnabled(logger, log.DebugLevel) {
+				return enrich(logger, scene).Debugf
+			}
+			return scribe.Nop
+		},
+		scribe.Debug: func(level scribe.Level, scene scribe.Scene) scribe.Logger {
+			if isLevelEnabled(logger, log.DebugLevel) {
+				return enrich(logger, scene).Debugf
+			}
+			return scribe.Nop
+		},
+		scribe.Info: func(level scribe.Level, scene scribe.Scene) scribe.Logger {
+			if isLevelEnabled(logger, log.InfoLevel) {
+				return enrich(logger, scene).Infof
+			}
+			return scribe.Nop
+		},
+		scribe.Warn: func(level scribe.Level, scene scribe.Scene) scribe.Logger {
+			if isLevelEnabled(logger, log.WarnLevel) {
+				return enrich(logger, scene).Warnf
+			}
+			return scribe.Nop
+		},
+		scribe.Error: func(level scribe.Level, scene scribe.Scene) scribe.Logger {
+			if isLevelEnabled(logger, log.ErrorLevel) {
+				return enrich(logger, scene).Errorf
+			}
+			return scribe.Nop
+		},
+	}
+}
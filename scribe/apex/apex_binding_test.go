@@ -0,0 +1,126 @@
+package apex
+
+import (
+	"testing"
+
+	"github.com/apex/log"
+	"github.com/apex/log/handlers/memory"
+	"github.com/obsidiandynamics/libstdgo/check"
+	"github.com/obsidiandynamics/libstdgo/scribe"
+	"github.com/stretchr/testify/assert"
+)
+
+func newLogger(level log.Level) (*log.Logger, *memory.Handler) {
+	h := memory.New()
+	return &log.Logger{Handler: h, Level: level}, h
+}
+
+func assertLastEntry(t *testing.T, h *memory.Handler, level log.Level, msg string) *log.Entry {
+	if assert.NotEmpty(t, h.Entries) {
+		last := h.Entries[len(h.Entries)-1]
+		assert.Equal(t, level, last.Level)
+		assert.Equal(t, msg, last.Message)
+		return last
+	}
+	return nil
+}
+
+func TestLogLevels_debugEnabled(t *testing.T) {
+	if scribe.TraceCompiledOut {
+		t.Skip("T() and D() are compiled out by the scribe_notrace tag")
+	}
+
+	logger, h := newLogger(log.DebugLevel)
+	s := scribe.New(Bind(logger))
+	s.SetEnabled(scribe.All)
+
+	s.T()("Alpha %d", 1)
+	assertLastEntry(t, h, log.DebugLevel, "Alpha 1")
+
+	s.D()("Bravo %d", 2)
+	assertLastEntry(t, h, log.DebugLevel, "Bravo 2")
+
+	s.I()("Charlie %d", 3)
+	assertLastEntry(t, h, log.InfoLevel, "Charlie 3")
+
+	s.W()("Delta %d", 4)
+	assertLastEntry(t, h, log.WarnLevel, "Delta 4")
+
+	s.E()("Echo %d", 5)
+	assertLastEntry(t, h, log.ErrorLevel, "Echo 5")
+
+	assert.Len(t, h.Entries, 5)
+}
+
+func TestLogLevels_fatalEnabled(t *testing.T) {
+	logger, h := newLogger(log.FatalLevel)
+	s := scribe.New(Bind(logger))
+	s.SetEnabled(scribe.All)
+
+	s.T()("Alpha %d", 1)
+	s.D()("Bravo %d", 2)
+	s.I()("Charlie %d", 3)
+	s.W()("Delta %d", 4)
+	s.E()("Echo %d", 5)
+	assert.Empty(t, h.Entries)
+}
+
+func TestLogLevels_warnEnabledViaEntry(t *testing.T) {
+	logger, h := newLogger(log.WarnLevel)
+	s := scribe.New(Bind(logger.WithField("component", "test")))
+	s.SetEnabled(scribe.All)
+
+	s.I()("Charlie %d", 3)
+	assert.Empty(t, h.Entries)
+
+	s.W()("Delta %d", 4)
+	entry := assertLastEntry(t, h, log.WarnLevel, "Delta 4")
+	if entry != nil {
+		assert.Equal(t, "test", entry.Fields["component"])
+	}
+}
+
+func TestIsLevelEnabled_customInterface(t *testing.T) {
+	type custom struct{ log.Interface }
+	assert.True(t, isLevelEnabled(custom{}, log.DebugLevel))
+	assert.True(t, isLevelEnabled(&log.Entry{}, log.DebugLevel))
+}
+
+func TestWithScene_fieldsAndError(t *testing.T) {
+	logger, h := newLogger(log.DebugLevel)
+	s := scribe.New(Bind(logger))
+
+	s.Capture(scribe.Scene{}).
+		I()("Charlie %d", 3)
+	entry := assertLastEntry(t, h, log.InfoLevel, "Charlie 3")
+	if entry != nil {
+		assert.Empty(t, entry.Fields)
+	}
+
+	s.Capture(scribe.Scene{Fields: scribe.Fields{"x": "y"}}).
+		I()("Charlie %d", 3)
+	entry = assertLastEntry(t, h, log.InfoLevel, "Charlie 3")
+	if entry != nil {
+		assert.Equal(t, log.Fields{"x": "y"}, entry.Fields)
+	}
+
+	s.Capture(scribe.Scene{Fields: scribe.Fields{"x": "y"}, Err: check.ErrSimulated}).
+		E()("Echo %d", 5)
+	entry = assertLastEntry(t, h, log.ErrorLevel, "Echo 5")
+	if entry != nil {
+		assert.Equal(t, "y", entry.Fields["x"])
+		assert.Equal(t, "simulated", entry.Fields["error"])
+	}
+}
+
+func TestWithScene_requestID(t *testing.T) {
+	logger, h := newLogger(log.DebugLevel)
+	s := scribe.New(Bind(logger))
+
+	s.Capture(scribe.Scene{}.WithRequestID("req-1")).
+		I()("Charlie %d", 3)
+	entry := assertLastEntry(t, h, log.InfoLevel, "Charlie 3")
+	if entry != nil {
+		assert.Equal(t, "req-1", entry.Fields["request_id"])
+	}
+}
@@ -0,0 +1,20 @@
+package apex
+
+import (
+	"testing"
+
+	"github.com/apex/log"
+	"github.com/obsidiandynamics/libstdgo/check"
+	"github.com/obsidiandynamics/libstdgo/scribe"
+)
+
+func Example() {
+	s := scribe.New(Bind(log.Log))
+
+	// Do some logging
+	s.I()("Important application message")
+}
+
+func TestExample(t *testing.T) {
+	check.RunTargetted(t, Example)
+}
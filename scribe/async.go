@@ -0,0 +1,99 @@
+package scribe
+
+import (
+	"context"
+
+	"github.com/obsidiandynamics/libstdgo/arity"
+)
+
+// CancelPolicy determines what happens to records that are pending in an Async queue when its context is
+// cancelled.
+type CancelPolicy int
+
+const (
+	// FlushOnCancel writes out all pending records before the worker exits.
+	FlushOnCancel CancelPolicy = iota
+
+	// DropOnCancel discards pending records, causing the worker to exit promptly.
+	DropOnCancel
+)
+
+type asyncRecord struct {
+	logger Logger
+	format string
+	args   []interface{}
+}
+
+// Async wraps the given facs such that log calls are queued and written out by a background worker, decoupling the
+// application from slow loggers. Up to capacity records may be pending at any time; once the queue is full, log
+// calls block until space becomes available.
+//
+// The worker runs until ctx is cancelled, at which point pending records are either flushed or dropped, according
+// to the optional policy (FlushOnCancel by default). Records logged after cancellation are discarded. The returned
+// channel is closed once the worker has exited, which can be used to wait for a flush to complete. For example:
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	facs, done := scribe.Async(ctx, scribe.StandardBinding(), 1000)
+//	s := scribe.New(facs)
+//	...
+//	cancel()
+//	<-done
+//
+// Note: the arguments to a log call are formatted by the worker, after the call returns; the caller must not mutate
+// them in the interim.
+func Async(ctx context.Context, facs LoggerFactories, capacity int, policy ...CancelPolicy) (LoggerFactories, <-chan struct{}) {
+	cancelPolicy := arity.SoleUntyped(FlushOnCancel, policy).(CancelPolicy)
+	queue := make(chan asyncRecord, capacity)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		for {
+			// Cancellation takes priority over pending records, so that the policy is applied deterministically.
+			if ctx.Err() != nil {
+				if cancelPolicy == FlushOnCancel {
+					flushAsync(queue)
+				}
+				return
+			}
+
+			select {
+			case record := <-queue:
+				record.logger(record.format, record.args...)
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	asyncFacs := make(LoggerFactories, len(facs))
+	for level, fac := range facs {
+		asyncFacs[level] = asyncFac(ctx, fac, queue)
+	}
+	return asyncFacs, done
+}
+
+func asyncFac(ctx context.Context, fac LoggerFactory, queue chan<- asyncRecord) LoggerFactory {
+	return func(level Level, scene Scene) Logger {
+		logger := fac(level, scene)
+		return func(format string, args ...interface{}) {
+			if ctx.Err() != nil {
+				return
+			}
+			select {
+			case queue <- asyncRecord{logger, format, args}:
+			case <-ctx.Done():
+			}
+		}
+	}
+}
+
+func flushAsync(queue <-chan asyncRecord) {
+	for {
+		select {
+		case record := <-queue:
+			record.logger(record.format, record.args...)
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package scribe
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/obsidiandynamics/libstdgo/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func awaitDone(t *testing.T, done <-chan struct{}) {
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		assert.Fail(t, "Timed out waiting for worker to exit")
+	}
+}
+
+// Produces factories that block on the first log call until the returned gate is closed, allowing subsequent
+// records to accumulate in the queue.
+func gatedFactories(m MockScribe) (LoggerFactories, chan struct{}, chan struct{}) {
+	gate, entered := make(chan struct{}), make(chan struct{})
+	first := true
+	return LoggerFactories{
+		All: func(level Level, scene Scene) Logger {
+			logger := m.Factories()[All](level, scene)
+			return func(format string, args ...interface{}) {
+				if first {
+					first = false
+					close(entered)
+					<-gate
+				}
+				logger(format, args...)
+			}
+		},
+	}, gate, entered
+}
+
+func TestAsync(t *testing.T) {
+	m := NewMock()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	facs, done := Async(ctx, m.Factories(), 10)
+	s := New(facs)
+
+	s.Capture(Scene{Fields: Fields{"foo": "bar"}}).I()("Info %d", 1)
+	s.W()("Warn %d", 2)
+	check.Wait(t, 10*time.Second).UntilAsserted(m.ContainsEntries().Passes(Count(2)))
+	m.Entries().Having(LogLevel(Info)).Having(MessageEqual("Info 1")).
+		Having(ASceneWith(AField("foo", "bar"))).Assert(t, Count(1))
+	m.Entries().Having(LogLevel(Warn)).Having(MessageEqual("Warn 2")).Assert(t, Count(1))
+
+	cancel()
+	awaitDone(t, done)
+
+	// Logging after cancellation is discarded.
+	s.E()("Error %d", 3)
+	m.Entries().Assert(t, Count(2))
+}
+
+func TestAsync_flushOnCancel(t *testing.T) {
+	m := NewMock()
+	gated, gate, entered := gatedFactories(m)
+	ctx, cancel := context.WithCancel(context.Background())
+	facs, done := Async(ctx, gated, 10, FlushOnCancel)
+	s := New(facs)
+
+	s.I()("first")
+	<-entered
+	s.I()("second")
+	s.I()("third")
+	cancel()
+	close(gate)
+
+	awaitDone(t, done)
+	m.Entries().Assert(t, Count(3))
+}
+
+func TestAsync_dropOnCancel(t *testing.T) {
+	m := NewMock()
+	gated, gate, entered := gatedFactories(m)
+	ctx, cancel := context.WithCancel(context.Background())
+	facs, done := Async(ctx, gated, 10, DropOnCancel)
+	s := New(facs)
+
+	s.I()("first")
+	<-entered
+	s.I()("second")
+	s.I()("third")
+	cancel()
+	close(gate)
+
+	awaitDone(t, done)
+	m.Entries().Having(MessageEqual("first")).Assert(t, Count(1))
+	m.Entries().Assert(t, Count(1))
+}
+
+func TestAsync_unblocksOnCancel(t *testing.T) {
+	m := NewMock()
+	gated, gate, entered := gatedFactories(m)
+	defer close(gate)
+	ctx, cancel := context.WithCancel(context.Background())
+	facs, _ := Async(ctx, gated, 0)
+	s := New(facs)
+
+	s.I()("first")
+	<-entered
+
+	logged := make(chan struct{})
+	go func() {
+		defer close(logged)
+		s.I()("second")
+	}()
+	cancel()
+	awaitDone(t, logged)
+}
@@ -0,0 +1,54 @@
+package scribe
+
+import "sort"
+
+// Attr is a single key-value attribute. Unlike Fields, a list of attributes preserves the order in which they were
+// given, which is carried through to WriteScene and to bindings that support ordered key-values.
+type Attr struct {
+	Key   string
+	Value interface{}
+}
+
+// Attrs is an ordered list of attributes that can be captured as part of a Scene.
+type Attrs []Attr
+
+// Fields converts the attributes to a Fields map. Where a key is repeated, the last value wins.
+func (a Attrs) Fields() Fields {
+	fields := make(Fields, len(a))
+	for _, attr := range a {
+		fields[attr.Key] = attr.Value
+	}
+	return fields
+}
+
+// OrderedFields returns the scene's fields (as obtained by AllFields) as an ordered list of attributes. Keys in
+// Attrs come first, in the order of their first appearance, followed by the remaining fields sorted by key. Each
+// key appears once, carrying the value resolved by AllFields. Bindings that support ordered key-values should
+// prefer this method over AllFields.
+func (s Scene) OrderedFields() Attrs {
+	fields := s.AllFields()
+	if len(fields) == 0 {
+		return nil
+	}
+
+	ordered := make(Attrs, 0, len(fields))
+	emitted := make(map[string]bool, len(fields))
+	for _, attr := range s.Attrs {
+		if !emitted[attr.Key] {
+			emitted[attr.Key] = true
+			ordered = append(ordered, Attr{attr.Key, fields[attr.Key]})
+		}
+	}
+
+	remaining := make([]string, 0, len(fields)-len(emitted))
+	for k := range fields {
+		if !emitted[k] {
+			remaining = append(remaining, k)
+		}
+	}
+	sort.Strings(remaining)
+	for _, k := range remaining {
+		ordered = append(ordered, Attr{k, fields[k]})
+	}
+	return ordered
+}
@@ -0,0 +1,88 @@
+package scribe
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/obsidiandynamics/libstdgo/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttrsFields(t *testing.T) {
+	attrs := Attrs{{"a", 1}, {"b", 2}, {"a", 3}}
+	assert.Equal(t, Fields{"a": 3, "b": 2}, attrs.Fields())
+	assert.Equal(t, Fields{}, Attrs{}.Fields())
+}
+
+func TestSceneOrderedFields(t *testing.T) {
+	assert.Nil(t, Scene{}.OrderedFields())
+
+	scene := Scene{
+		Fields:    Fields{"yankee": "y", "bravo": "b", "mike": "fields"},
+		Attrs:     Attrs{{"zulu", 1}, {"mike", 2}, {"alpha", 3}, {"zulu", 4}},
+		RequestID: "req-1",
+	}
+	assert.Equal(t, Attrs{
+		{"zulu", 4},
+		{"mike", 2},
+		{"alpha", 3},
+		{"bravo", "b"},
+		{RequestIDFieldKey, "req-1"},
+		{"yankee", "y"},
+	}, scene.OrderedFields())
+}
+
+func TestScene_allFieldsWithAttrs(t *testing.T) {
+	scene := Scene{Fields: Fields{"a": 1, "b": 2}, Attrs: Attrs{{"b", 3}, {"c", 4}}}
+	assert.Equal(t, Fields{"a": 1, "b": 3, "c": 4}, scene.AllFields())
+	assert.Equal(t, Fields{"a": 1, "b": 2}, scene.Fields)
+	assert.True(t, Scene{Attrs: Attrs{{"a", 1}}}.IsSet())
+}
+
+func TestWriteScene_orderedAttrs(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	WriteScene(buffer, Scene{Attrs: Attrs{{"zulu", 1}, {"alpha", "two"}, {"mike", 3.5}}, Err: check.ErrSimulated})
+	assert.Equal(t, "<zulu:1 alpha:two mike:3.5> <simulated>", buffer.String())
+}
+
+func TestCaptureAttrs(t *testing.T) {
+	m := NewMock()
+	s := New(m.Factories())
+
+	s.CaptureAttrs(Attr{"zulu", 1}, Attr{"alpha", 2}).I()("ordered")
+	entries := m.Entries().Having(MessageEqual("ordered")).List()
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, Attrs{{"zulu", 1}, {"alpha", 2}}, entries[0].Scene.Attrs)
+	}
+}
+
+func TestCaptureAttrs_appendScene(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	s := New(ShimFacs(LoggerFactories{All: Fac(func(format string, args ...interface{}) {
+		fmt.Fprintf(buffer, format, args...)
+	})}, AppendScene()))
+
+	s.CaptureAttrs(Attr{"zulu", 1}, Attr{"alpha", 2}, Attr{"mike", 3}).I()("message")
+	assert.Equal(t, "message <zulu:1 alpha:2 mike:3>", buffer.String())
+}
+
+func TestSceneCloneAndMerge_attrs(t *testing.T) {
+	scene := Scene{Attrs: Attrs{{"a", 1}}}
+	clone := scene.Clone()
+	clone.Attrs[0].Value = 2
+	assert.Equal(t, 1, scene.Attrs[0].Value)
+	assert.Nil(t, Scene{}.Clone().Attrs)
+
+	merged := scene.Merge(Scene{Attrs: Attrs{{"b", 2}}})
+	assert.Equal(t, Attrs{{"a", 1}, {"b", 2}}, merged.Attrs)
+	assert.Equal(t, Attrs{{"a", 1}}, scene.Attrs)
+}
+
+func TestSceneRedactedString_attrs(t *testing.T) {
+	scene := Scene{Attrs: Attrs{{"user", "alice"}, {"password", "hunter2"}}}
+	str := scene.RedactedString("password")
+	assert.Contains(t, str, "{user alice}")
+	assert.Contains(t, str, "{password [REDACTED]}")
+	assert.Equal(t, "hunter2", scene.Attrs[1].Value)
+}
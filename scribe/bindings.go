@@ -2,10 +2,18 @@ package scribe
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"log"
+	"os"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/obsidiandynamics/libstdgo/arity"
+	"github.com/obsidiandynamics/libstdgo/concurrent"
 )
 
 /*
@@ -56,9 +64,38 @@ func BindLogPrintf(logger ...*log.Logger) LoggerFactories {
 //  *scene = Scene{...}
 //  *format = "new format with %s %s"
 //  *args = []interface{}{"new", "args"}
+//
+// A hook may also discard the entry altogether by calling Suppress(format), in which case the underlying logger is
+// not invoked. Suppression is honoured by ShimFac and ShimFacs.
 type Hook func(level Level, scene *Scene, format *string, args *[]interface{})
 
-// AppendScene is a hook that appends the contents of the captured scene after the formatted log message.
+// A sentinel format that marks an entry as suppressed. The leading NUL makes a collision with a genuine format
+// string implausible.
+const suppressedFormat = "\x00scribe:suppressed"
+
+// Suppress is called from within a Hook to discard the entry being logged.
+func Suppress(format *string) {
+	*format = suppressedFormat
+}
+
+// DropIf is a hook that discards entries satisfying the given predicate, which is evaluated on the level, the format
+// and the (unformatted) args of each entry — for example, to silence a known-noisy message:
+//
+//	facs = scribe.ShimFacs(facs, scribe.DropIf(func(level scribe.Level, format string, args []interface{}) bool {
+//		return strings.HasPrefix(format, "Retrying connection")
+//	}))
+//
+// Dropped entries are suppressed (as per Suppress), and so never reach the underlying logger.
+func DropIf(fn func(level Level, format string, args []interface{}) bool) Hook {
+	return func(level Level, scene *Scene, format *string, args *[]interface{}) {
+		if fn(level, *format, *args) {
+			Suppress(format)
+		}
+	}
+}
+
+// AppendScene is a hook that appends the contents of the captured scene after the formatted log message. It may be
+// composed with other middleware via HookMiddleware.
 func AppendScene() Hook {
 	return func(level Level, scene *Scene, format *string, args *[]interface{}) {
 		buffer := &bytes.Buffer{}
@@ -70,6 +107,110 @@ func AppendScene() Hook {
 	}
 }
 
+// HostFieldKey is the field name under which ProcessFields injects the hostname.
+var HostFieldKey = "host"
+
+// PidFieldKey is the field name under which ProcessFields injects the process ID.
+var PidFieldKey = "pid"
+
+// Hostname lookup, substitutable for testing.
+var hostname = os.Hostname
+
+// ProcessFields is a hook that injects the hostname (keyed under HostFieldKey) and the process ID (keyed under
+// PidFieldKey) into the scene fields, which is useful for telling apart the logs of multiple instances. Both
+// values are computed once, when the hook is created. If the hostname cannot be determined, the host field is
+// omitted. Fields already present in the scene take precedence over the injected ones.
+//
+// Combined with ShimFacs, this enriches all logs emitted through the shimmed factories.
+func ProcessFields() Hook {
+	processFields := Fields{PidFieldKey: os.Getpid()}
+	if host, err := hostname(); err == nil {
+		processFields[HostFieldKey] = host
+	}
+
+	return func(level Level, scene *Scene, format *string, args *[]interface{}) {
+		fields := make(Fields, len(scene.Fields)+len(processFields))
+		for k, v := range processFields {
+			fields[k] = v
+		}
+		for k, v := range scene.Fields {
+			fields[k] = v
+		}
+		scene.Fields = fields
+	}
+}
+
+// SequenceField is a hook that injects a sequence number into the scene fields under the given name, allowing the
+// order of entries to be established irrespective of the resolution of their timestamps. Sequence numbers start at 1
+// and are unique and increasing across all entries passing through the returned hook, including those logged
+// concurrently. Separate calls to SequenceField yield independent sequences.
+func SequenceField(name string) Hook {
+	sequence := concurrent.NewAtomicCounter()
+	return func(level Level, scene *Scene, format *string, args *[]interface{}) {
+		fields := make(Fields, len(scene.Fields)+1)
+		for k, v := range scene.Fields {
+			fields[k] = v
+		}
+		fields[name] = sequence.Inc()
+		scene.Fields = fields
+	}
+}
+
+// CallerFuncFieldKey is the field name under which WithCallerFunc injects the fully qualified name of the calling
+// function.
+var CallerFuncFieldKey = "func"
+
+// CallerFileFieldKey is the field name under which WithCallerFunc injects the path of the calling source file.
+var CallerFileFieldKey = "file"
+
+// CallerLineFieldKey is the field name under which WithCallerFunc injects the calling line number.
+var CallerLineFieldKey = "line"
+
+// Number of stack frames between the hook and the call site, when invoked from a ShimFac logger: the hook itself,
+// followed by the shimmed logger.
+const callerShimOffset = 2
+
+// The name of the function returned by verbatim, whose frame WithCallerFunc skips.
+var verbatimFuncName = runtime.FuncForPC(reflect.ValueOf(verbatim(Nop)).Pointer()).Name()
+
+// WithCallerFunc is a hook that injects the call site of each entry into the scene fields: the function name (as per
+// runtime.FuncForPC, keyed under CallerFuncFieldKey), the file (keyed under CallerFileFieldKey) and the line
+// (keyed under CallerLineFieldKey). Fields already present in the scene take precedence over the injected ones. If
+// the call site cannot be determined, no fields are injected.
+//
+// The hook accounts for the shim applied by ShimFac and ShimFacs, so that with a skip of 0, the reported call site
+// is the code that invoked the logger. Increase skip by one for each further function between the call site of
+// interest and the logger — for example, 1 to report the caller of a logging helper function. The frame added by a
+// logger obtained from MsgLogger is skipped automatically. As the call site is recorded as a scene field, the hook
+// must be applied with ShimFacs rather than HookMiddleware.
+func WithCallerFunc(skip int) Hook {
+	return func(level Level, scene *Scene, format *string, args *[]interface{}) {
+		offset := callerShimOffset
+		if pc, _, _, ok := runtime.Caller(offset); ok {
+			if fn := runtime.FuncForPC(pc); fn != nil && fn.Name() == verbatimFuncName {
+				offset++
+			}
+		}
+		pc, file, line, ok := runtime.Caller(offset + skip)
+		if !ok {
+			return
+		}
+		callerFields := Fields{CallerFileFieldKey: file, CallerLineFieldKey: line}
+		if fn := runtime.FuncForPC(pc); fn != nil {
+			callerFields[CallerFuncFieldKey] = fn.Name()
+		}
+
+		fields := make(Fields, len(scene.Fields)+len(callerFields))
+		for k, v := range callerFields {
+			fields[k] = v
+		}
+		for k, v := range scene.Fields {
+			fields[k] = v
+		}
+		scene.Fields = fields
+	}
+}
+
 // Space appends a whitespace character to the given buffer if the latter is non-empty. This function
 // is used to separate fields.
 func Space(buffer *bytes.Buffer) {
@@ -78,21 +219,24 @@ func Space(buffer *bytes.Buffer) {
 	}
 }
 
-// WriteScene is a utility for compactly writing scene contents to an output writer.
+// WriteScene is a utility for compactly writing scene contents to an output writer. Fields are written in the order
+// given by Scene.OrderedFields — attributes captured via Attrs first, in their original order.
+//
+// This is the legacy '<k:v>' format, in which all field values are rendered as strings using FormatFieldValue
+// (which defaults to fmt.Sprint, unless a formatter has been registered for the value's type). As such, a string
+// "5" is indistinguishable from an int 5. Use WriteSceneJSON or WriteSceneLogfmt where the value types must be
+// preserved.
 func WriteScene(buffer *bytes.Buffer, scene Scene) {
-	if len(scene.Fields) > 0 {
+	if fields := scene.OrderedFields(); len(fields) > 0 {
 		Space(buffer)
 		buffer.Write([]byte("<"))
-		numFields := len(scene.Fields)
-		i := 0
-		for k, v := range scene.Fields {
-			buffer.Write([]byte(k))
+		for i, attr := range fields {
+			buffer.Write([]byte(attr.Key))
 			buffer.Write([]byte(":"))
-			buffer.Write([]byte(fmt.Sprint(v)))
-			if i < numFields-1 {
+			buffer.Write([]byte(FormatFieldValue(attr.Value)))
+			if i < len(fields)-1 {
 				buffer.Write([]byte(" "))
 			}
-			i++
 		}
 		buffer.Write([]byte(">"))
 	}
@@ -100,11 +244,111 @@ func WriteScene(buffer *bytes.Buffer, scene Scene) {
 	if scene.Err != nil {
 		Space(buffer)
 		buffer.Write([]byte("<"))
+		if RenderErrorKey {
+			buffer.Write([]byte(ErrorFieldKey))
+			buffer.Write([]byte(":"))
+		}
 		buffer.Write([]byte(scene.Err.Error()))
 		buffer.Write([]byte(">"))
 	}
 }
 
+// Obtains a copy of the scene fields (including the request ID, if set), with the error (if set) keyed under
+// ErrorFieldKey. Error-valued fields are rendered as strings; all other values are left as-is.
+func structuredFields(scene Scene) map[string]interface{} {
+	allFields := scene.AllFields()
+	fields := make(map[string]interface{}, len(allFields)+1)
+	for k, v := range allFields {
+		if err, ok := v.(error); ok {
+			fields[k] = err.Error()
+		} else {
+			fields[k] = v
+		}
+	}
+	if scene.Err != nil {
+		fields[ErrorFieldKey] = scene.Err.Error()
+	}
+	return fields
+}
+
+func sortedKeys(fields map[string]interface{}) []string {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// WriteSceneJSON writes the scene contents to the buffer as a JSON object, keyed by field name, with the error
+// (if set) keyed under ErrorFieldKey. Unlike WriteScene, value types are preserved: numbers and booleans are
+// rendered as JSON numbers and booleans, and strings as JSON strings. Errors are rendered as strings; values that
+// cannot be marshalled to JSON fall back to their fmt.Sprint representation. If the scene has neither fields
+// nor an error, nothing is written.
+func WriteSceneJSON(buffer *bytes.Buffer, scene Scene) {
+	fields := structuredFields(scene)
+	if len(fields) == 0 {
+		return
+	}
+
+	Space(buffer)
+	buffer.WriteString("{")
+	for i, k := range sortedKeys(fields) {
+		if i > 0 {
+			buffer.WriteString(",")
+		}
+		key, _ := json.Marshal(k)
+		buffer.Write(key)
+		buffer.WriteString(":")
+		value, err := json.Marshal(fields[k])
+		if err != nil {
+			value, _ = json.Marshal(fmt.Sprint(fields[k]))
+		}
+		buffer.Write(value)
+	}
+	buffer.WriteString("}")
+}
+
+// WriteSceneLogfmt writes the scene contents to the buffer as space-separated key=value pairs in logfmt style,
+// sorted by key, with the error (if set) keyed under ErrorFieldKey. Numbers and booleans are written bare, while
+// strings are quoted where necessary — including where a string would otherwise be mistaken for a number or a
+// boolean — so that value types can be recovered by the reader.
+func WriteSceneLogfmt(buffer *bytes.Buffer, scene Scene) {
+	fields := structuredFields(scene)
+	for _, k := range sortedKeys(fields) {
+		Space(buffer)
+		buffer.WriteString(k)
+		buffer.WriteString("=")
+		buffer.WriteString(logfmtValue(fields[k]))
+	}
+}
+
+func logfmtValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return fmt.Sprint(v)
+	case string:
+		return logfmtString(v)
+	default:
+		return logfmtString(fmt.Sprint(v))
+	}
+}
+
+func logfmtString(str string) string {
+	if str == "" || str == "null" || strings.ContainsAny(str, " =\"\t\n") {
+		return strconv.Quote(str)
+	}
+	if _, err := strconv.ParseFloat(str, 64); err == nil {
+		return strconv.Quote(str)
+	}
+	if _, err := strconv.ParseBool(str); err == nil {
+		return strconv.Quote(str)
+	}
+	return str
+}
+
 // ShimFacs applies a shim to all factories in facs, using the given hook, returning an equivalent map of shimmed factories.
 func ShimFacs(facs LoggerFactories, hook Hook) LoggerFactories {
 	shimmedFacs := LoggerFactories{}
@@ -124,6 +368,9 @@ func ShimFac(fac LoggerFactory, hook Hook) LoggerFactory {
 	return func(level Level, scene Scene) Logger {
 		return func(format string, args ...interface{}) {
 			hook(level, &scene, &format, &args)
+			if format == suppressedFormat {
+				return
+			}
 			fac(level, scene)(format, args...)
 		}
 	}
@@ -2,8 +2,11 @@ package scribe
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"log"
+	"os"
+	"runtime"
 	"testing"
 
 	"github.com/obsidiandynamics/libstdgo/check"
@@ -23,6 +26,10 @@ func TestStandardBinding(t *testing.T) {
 }
 
 func TestStandardBinding_customWriter(t *testing.T) {
+	if TraceCompiledOut {
+		t.Skip("T() and D() are compiled out by the scribe_notrace tag")
+	}
+
 	buffer := bytes.Buffer{}
 	logger := log.New(&buffer, "", log.Llongfile)
 	l := New(BindLogPrintf(logger))
@@ -96,6 +103,37 @@ func TestAppendScene_twoFields(t *testing.T) {
 	assert.Contains(t, msg, "charlie:delta")
 }
 
+func TestAppendScene_renderErrorKey(t *testing.T) {
+	defer func(render bool) { RenderErrorKey = render }(RenderErrorKey)
+	RenderErrorKey = true
+
+	scene := Scene{Err: check.ErrSimulated}
+	format := "%d %d"
+	args := []interface{}{1, 2}
+	AppendScene()(Info, &scene, &format, &args)
+	assert.Equal(t, "1 2 <Err:simulated>", fmt.Sprintf(format, args...))
+}
+
+func TestAppendScene_customErrorFieldKey(t *testing.T) {
+	defer func(key string) { ErrorFieldKey = key }(ErrorFieldKey)
+	ErrorFieldKey = "error"
+
+	scene := Scene{Err: check.ErrSimulated}
+	format := "%d %d"
+	args := []interface{}{1, 2}
+	AppendScene()(Info, &scene, &format, &args)
+	assert.Equal(t, "1 2 <simulated>", fmt.Sprintf(format, args...))
+
+	defer func(render bool) { RenderErrorKey = render }(RenderErrorKey)
+	RenderErrorKey = true
+
+	scene = Scene{Err: check.ErrSimulated}
+	format = "%d %d"
+	args = []interface{}{1, 2}
+	AppendScene()(Info, &scene, &format, &args)
+	assert.Equal(t, "1 2 <error:simulated>", fmt.Sprintf(format, args...))
+}
+
 func TestShimFacs_withAppendScene(t *testing.T) {
 	captured := ""
 	logger := func(format string, args ...interface{}) {
@@ -136,3 +174,229 @@ func TestShimFac_mutateAllCallArgs(t *testing.T) {
 	assert.Equal(t, "tomarf", capturedFormat)
 	assert.Equal(t, []interface{}{"argX", "argY"}, capturedArgs)
 }
+
+func TestWriteSceneJSON(t *testing.T) {
+	cases := []struct {
+		scene  Scene
+		expect string
+	}{
+		{Scene{}, ""},
+		{Scene{Fields: Fields{"int": 5}}, `{"int":5}`},
+		{Scene{Fields: Fields{"str": "5"}}, `{"str":"5"}`},
+		{Scene{Fields: Fields{"bool": true, "float": 1.5, "nil": nil}}, `{"bool":true,"float":1.5,"nil":null}`},
+		{Scene{Fields: Fields{"err": check.ErrSimulated}}, `{"err":"simulated"}`},
+		{Scene{Fields: Fields{"chan": make(chan int)}}, `{"chan":"0x`},
+		{Scene{Fields: Fields{"a": 1}, Err: check.ErrSimulated}, `{"Err":"simulated","a":1}`},
+		{Scene{Fields: Fields{"a": 1}, RequestID: "req-1"}, `{"a":1,"request_id":"req-1"}`},
+	}
+
+	for _, c := range cases {
+		t := check.Intercept(t).Mutate(check.Appendf("case %v", c))
+		buffer := &bytes.Buffer{}
+		WriteSceneJSON(buffer, c.scene)
+		if c.expect == "" {
+			assert.Empty(t, buffer.String())
+		} else {
+			assert.Contains(t, buffer.String(), c.expect)
+		}
+	}
+}
+
+func TestWriteSceneJSON_appendsWithSpace(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteString("message")
+	WriteSceneJSON(buffer, Scene{Fields: Fields{"int": 5}})
+	assert.Equal(t, `message {"int":5}`, buffer.String())
+}
+
+func TestWriteSceneLogfmt(t *testing.T) {
+	cases := []struct {
+		scene  Scene
+		expect string
+	}{
+		{Scene{}, ""},
+		{Scene{Fields: Fields{"int": 5}}, `int=5`},
+		{Scene{Fields: Fields{"str": "5"}}, `str="5"`},
+		{Scene{Fields: Fields{"str": "true"}}, `str="true"`},
+		{Scene{Fields: Fields{"str": "plain"}}, `str=plain`},
+		{Scene{Fields: Fields{"str": "with space"}}, `str="with space"`},
+		{Scene{Fields: Fields{"str": ""}}, `str=""`},
+		{Scene{Fields: Fields{"bool": false, "float": 1.5, "nil": nil}}, `bool=false float=1.5 nil=null`},
+		{Scene{Fields: Fields{"list": []int{1, 2}}}, `list="[1 2]"`},
+		{Scene{Fields: Fields{"a": 1}, Err: check.ErrSimulated}, `Err=simulated a=1`},
+		{Scene{RequestID: "req-1"}, `request_id=req-1`},
+	}
+
+	for _, c := range cases {
+		t := check.Intercept(t).Mutate(check.Appendf("case %v", c))
+		buffer := &bytes.Buffer{}
+		WriteSceneLogfmt(buffer, c.scene)
+		assert.Equal(t, c.expect, buffer.String())
+	}
+}
+
+func TestWriteScene_errorChain(t *testing.T) {
+	defer func(render bool) { RenderErrorChain = render }(RenderErrorChain)
+	RenderErrorChain = true
+	top := fmt.Errorf("top: %w", fmt.Errorf("middle: %w", errors.New("root")))
+
+	buffer := &bytes.Buffer{}
+	WriteScene(buffer, Scene{Err: top})
+	assert.Equal(t, "<err_chain:[middle: root root]> <top: middle: root>", buffer.String())
+
+	buffer.Reset()
+	WriteSceneJSON(buffer, Scene{Err: top})
+	assert.Equal(t, `{"Err":"top: middle: root","err_chain":["middle: root","root"]}`, buffer.String())
+}
+
+func TestProcessFields(t *testing.T) {
+	host, err := os.Hostname()
+	assert.Nil(t, err)
+
+	m := NewMock()
+	s := New(ShimFacs(m.Factories(), ProcessFields()))
+	s.I()("plain")
+	s.Capture(Scene{Fields: Fields{"foo": "bar", HostFieldKey: "custom"}}).I()("with scene")
+
+	m.Entries().Having(MessageEqual("plain")).
+		Having(ASceneWith(AField(HostFieldKey, host))).
+		Having(ASceneWith(AField(PidFieldKey, os.Getpid()))).
+		Assert(t, Count(1))
+	m.Entries().Having(MessageEqual("with scene")).
+		Having(ASceneWith(AField("foo", "bar"))).
+		Having(ASceneWith(AField(HostFieldKey, "custom"))).
+		Having(ASceneWith(AField(PidFieldKey, os.Getpid()))).
+		Assert(t, Count(1))
+}
+
+func TestProcessFields_hostnameLookupFailure(t *testing.T) {
+	defer func(h func() (string, error)) { hostname = h }(hostname)
+	hostname = func() (string, error) { return "", check.ErrSimulated }
+
+	scene := Scene{}
+	format := "msg"
+	args := []interface{}{}
+	ProcessFields()(Info, &scene, &format, &args)
+	assert.Equal(t, Fields{PidFieldKey: os.Getpid()}, scene.Fields)
+}
+
+func TestSequenceField(t *testing.T) {
+	m := NewMock()
+	l := New(ShimFacs(m.Factories(), SequenceField("seq")))
+
+	const goroutines, iterations = 8, 100
+	check.Hammer(t, goroutines, iterations, func(g, i int) {
+		l.Capture(Scene{Fields: Fields{"goroutine": g}}).I()("message %d", i)
+	})
+
+	entries := m.Entries().List()
+	assert.Len(t, entries, goroutines*iterations)
+	seen := map[int64]bool{}
+	byGoroutine := map[int][]int64{}
+	for _, e := range entries {
+		seq := e.Scene.Fields["seq"].(int64)
+		assert.False(t, seen[seq], "duplicate sequence %d", seq)
+		seen[seq] = true
+		assert.True(t, seq >= 1 && seq <= goroutines*iterations)
+
+		g := e.Scene.Fields["goroutine"].(int)
+		byGoroutine[g] = append(byGoroutine[g], seq)
+	}
+
+	// Within a goroutine, sequence numbers increase.
+	for _, seqs := range byGoroutine {
+		check.Monotonic(t, seqs, true)
+	}
+
+	// An independent hook yields an independent sequence.
+	scene := Scene{}
+	format := "msg"
+	args := []interface{}{}
+	SequenceField("seq")(Info, &scene, &format, &args)
+	assert.Equal(t, Fields{"seq": int64(1)}, scene.Fields)
+}
+
+func TestWithCallerFunc(t *testing.T) {
+	m := NewMock()
+	l := New(ShimFacs(m.Factories(), WithCallerFunc(0)))
+	_, file, line, _ := runtime.Caller(0)
+	l.I()("message")
+
+	m.Entries().Having(ASceneWith(AField(CallerFuncFieldKey, "github.com/obsidiandynamics/libstdgo/scribe.TestWithCallerFunc"))).
+		Having(ASceneWith(AField(CallerFileFieldKey, file))).
+		Having(ASceneWith(AField(CallerLineFieldKey, line+1))).
+		Assert(t, Count(1))
+}
+
+func logViaHelper(l Scribe) {
+	l.I()("message")
+}
+
+func TestWithCallerFunc_skip(t *testing.T) {
+	m := NewMock()
+	l := New(ShimFacs(m.Factories(), WithCallerFunc(0)))
+	logViaHelper(l)
+	m.Entries().Having(ASceneWith(AField(CallerFuncFieldKey, "github.com/obsidiandynamics/libstdgo/scribe.logViaHelper"))).
+		Assert(t, Count(1))
+
+	m.Reset()
+	l = New(ShimFacs(m.Factories(), WithCallerFunc(1)))
+	logViaHelper(l)
+	m.Entries().Having(ASceneWith(AField(CallerFuncFieldKey, "github.com/obsidiandynamics/libstdgo/scribe.TestWithCallerFunc_skip"))).
+		Assert(t, Count(1))
+}
+
+func TestWithCallerFunc_msgLogger(t *testing.T) {
+	m := NewMock()
+	l := New(ShimFacs(m.Factories(), WithCallerFunc(0)))
+	_, file, line, _ := runtime.Caller(0)
+	l.MsgLogger(Info)("100% verbatim")
+	l.Capture(Scene{Fields: Fields{"foo": "bar"}}).MsgLogger(Info)("captured")
+
+	m.Entries().Having(MessageEqual("100% verbatim")).
+		Having(ASceneWith(AField(CallerFuncFieldKey, "github.com/obsidiandynamics/libstdgo/scribe.TestWithCallerFunc_msgLogger"))).
+		Having(ASceneWith(AField(CallerFileFieldKey, file))).
+		Having(ASceneWith(AField(CallerLineFieldKey, line+1))).
+		Assert(t, Count(1))
+	m.Entries().Having(MessageEqual("captured")).
+		Having(ASceneWith(AField(CallerFuncFieldKey, "github.com/obsidiandynamics/libstdgo/scribe.TestWithCallerFunc_msgLogger"))).
+		Having(ASceneWith(AField(CallerLineFieldKey, line+2))).
+		Assert(t, Count(1))
+}
+
+func TestWithCallerFunc_existingFieldsRetained(t *testing.T) {
+	m := NewMock()
+	l := New(ShimFacs(m.Factories(), WithCallerFunc(0)))
+	l.Capture(Scene{Fields: Fields{CallerFuncFieldKey: "custom", "foo": "bar"}}).I()("message")
+	m.Entries().Having(ASceneWith(AField(CallerFuncFieldKey, "custom"))).
+		Having(ASceneWith(AField("foo", "bar"))).
+		Having(ASceneWith(AFieldNamed(CallerLineFieldKey))).
+		Assert(t, Count(1))
+}
+
+func TestWithCallerFunc_unresolvable(t *testing.T) {
+	scene := Scene{}
+	format := "msg"
+	args := []interface{}{}
+	WithCallerFunc(1<<20)(Info, &scene, &format, &args)
+	assert.Nil(t, scene.Fields)
+}
+
+func TestDropIf(t *testing.T) {
+	m := NewMock()
+	noisy := func(level Level, format string, args []interface{}) bool {
+		return level < Error && format == "Retrying %s" && len(args) == 1 && args[0] == "connection"
+	}
+	l := New(ShimFacs(m.Factories(), DropIf(noisy)))
+
+	l.I()("Retrying %s", "connection")
+	l.Capture(Scene{Fields: Fields{"foo": "bar"}}).W()("Retrying %s", "connection")
+	l.I()("Retrying %s", "request")
+	l.E()("Retrying %s", "connection")
+	l.I()("Connected")
+
+	m.Entries().Assert(t, Count(3))
+	m.Entries().Having(MessageEqual("Retrying request")).Assert(t, Count(1))
+	m.Entries().Having(LogLevel(Error)).Having(MessageEqual("Retrying connection")).Assert(t, Count(1))
+	m.Entries().Having(MessageEqual("Connected")).Assert(t, Count(1))
+}
@@ -0,0 +1,23 @@
+package scribe
+
+import "context"
+
+// The key under which a Scene is stored in a context. Being unexported, it cannot collide with keys defined in
+// other packages.
+type sceneContextKey struct{}
+
+// ContextWithScene returns a copy of the parent context that carries the given scene. This allows middleware to stash
+// a scene in a request context, for retrieval by handlers via SceneFromContext. A scene stored in a derived context
+// replaces the one stored in its parent.
+//
+// The scene is stored by value; however, its Fields map is shared, and so should not be modified once stored.
+func ContextWithScene(ctx context.Context, scene Scene) context.Context {
+	return context.WithValue(ctx, sceneContextKey{}, scene)
+}
+
+// SceneFromContext retrieves the scene stored in the context by ContextWithScene, returning the scene and true if one
+// is present, or an empty scene and false otherwise. The result can be passed directly to Scribe.Capture.
+func SceneFromContext(ctx context.Context) (Scene, bool) {
+	scene, ok := ctx.Value(sceneContextKey{}).(Scene)
+	return scene, ok
+}
@@ -0,0 +1,69 @@
+package scribe
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/obsidiandynamics/libstdgo/check"
+	"github.com/obsidiandynamics/libstdgo/concurrent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSceneFromContext_roundTrip(t *testing.T) {
+	scene := Scene{Fields: Fields{"foo": "bar"}, Err: check.ErrSimulated}.WithRequestID("req-1")
+	ctx := ContextWithScene(context.Background(), scene)
+
+	retrieved, ok := SceneFromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, scene, retrieved)
+
+	m := NewMock()
+	l := New(m.Factories())
+	l.Capture(retrieved).I()("handled")
+	m.Entries().Having(ASceneWith(AField("foo", "bar"))).Having(ASceneWith(ARequestID("req-1"))).Assert(t, Count(1))
+}
+
+func TestSceneFromContext_absent(t *testing.T) {
+	retrieved, ok := SceneFromContext(context.Background())
+	assert.False(t, ok)
+	assert.Equal(t, Scene{}, retrieved)
+
+	// A value stored under an unrelated key of the same underlying type is not mistaken for a scene.
+	type otherKey struct{}
+	ctx := context.WithValue(context.Background(), otherKey{}, Scene{Fields: Fields{"foo": "bar"}})
+	_, ok = SceneFromContext(ctx)
+	assert.False(t, ok)
+}
+
+func TestSceneFromContext_derivedReplacesParent(t *testing.T) {
+	parent := ContextWithScene(context.Background(), Scene{Fields: Fields{"foo": "bar"}})
+	child := ContextWithScene(parent, Scene{Fields: Fields{"baz": "qux"}})
+
+	retrieved, _ := SceneFromContext(child)
+	assert.Equal(t, Scene{Fields: Fields{"baz": "qux"}}, retrieved)
+	retrieved, _ = SceneFromContext(parent)
+	assert.Equal(t, Scene{Fields: Fields{"foo": "bar"}}, retrieved)
+}
+
+func TestSceneFromContext_awaitLogged(t *testing.T) {
+	m := NewMock()
+	s := New(m.Factories())
+	s.SetEnabled(All)
+	ctx := ContextWithScene(context.Background(), Scene{Fields: Fields{"loop": "test"}})
+	scene, _ := SceneFromContext(ctx)
+	logger := s.Capture(scene)
+
+	c := concurrent.NewAtomicCounter()
+	cond := func() bool {
+		logger.L(Debug)("Checking counter")
+		return c.Inc() >= 3
+	}
+
+	assert.True(t, concurrent.AwaitCtxLogged(ctx, cond, logger.L(Trace), time.Microsecond))
+	m.Entries().Having(LogLevel(Trace)).Assert(t, Count(3))
+	m.Entries().Having(LogLevel(Debug)).Assert(t, Count(3))
+	m.Entries().Having(ASceneWith(AField("loop", "test"))).Assert(t, Count(6))
+	m.Entries().Having(LogLevel(Trace)).
+		Having(MessageContaining("Await poll 3: satisfied=true")).Assert(t, Count(1))
+}
@@ -0,0 +1,55 @@
+package scribe
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// Duration is a field value type for elapsed times, such as the time taken by an operation. Unlike a bare
+// time.Duration (which some bindings render as an integer number of nanoseconds), a Duration is rendered
+// consistently across bindings and formatters as a string, in accordance with DurationUnit. Use DurationField to
+// construct a Duration field.
+type Duration time.Duration
+
+// DurationUnit is the unit in which Duration fields are rendered; e.g. with a unit of time.Millisecond, a 1.2 second
+// Duration renders as '1200ms'. The unit must be one of time.Nanosecond, time.Microsecond, time.Millisecond,
+// time.Second, time.Minute or time.Hour. If zero (the default), or some other value, durations are rendered using
+// time.Duration's String method, e.g. '1.2s'. It should be set during application initialisation.
+var DurationUnit time.Duration = 0
+
+var durationUnitSuffixes = map[time.Duration]string{
+	time.Nanosecond:  "ns",
+	time.Microsecond: "µs",
+	time.Millisecond: "ms",
+	time.Second:      "s",
+	time.Minute:      "m",
+	time.Hour:        "h",
+}
+
+// DurationField produces a name-value pair for a duration field, for adding to Fields. For example —
+//
+//	name, value := scribe.DurationField("took", time.Since(start))
+//	s.Capture(scribe.Scene{Fields: scribe.Fields{name: value}}).I()("Done")
+func DurationField(name string, d time.Duration) (string, interface{}) {
+	return name, Duration(d)
+}
+
+// String renders the duration in accordance with DurationUnit.
+func (d Duration) String() string {
+	if suffix, ok := durationUnitSuffixes[DurationUnit]; ok {
+		return strconv.FormatFloat(float64(d)/float64(DurationUnit), 'f', -1, 64) + suffix
+	}
+	return time.Duration(d).String()
+}
+
+// MarshalJSON renders the duration as a JSON string, in the same form as String.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func init() {
+	RegisterFieldFormatter(Duration(0), func(value interface{}) string {
+		return value.(Duration).String()
+	})
+}
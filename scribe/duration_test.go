@@ -0,0 +1,59 @@
+package scribe
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDurationField(t *testing.T) {
+	name, value := DurationField("took", 1200*time.Millisecond)
+	assert.Equal(t, "took", name)
+	assert.Equal(t, Duration(1200*time.Millisecond), value)
+}
+
+func TestDuration_string(t *testing.T) {
+	defer func(unit time.Duration) { DurationUnit = unit }(DurationUnit)
+	d := Duration(1200 * time.Millisecond)
+
+	cases := []struct {
+		unit   time.Duration
+		expect string
+	}{
+		{0, "1.2s"},
+		{time.Nanosecond, "1200000000ns"},
+		{time.Microsecond, "1200000µs"},
+		{time.Millisecond, "1200ms"},
+		{time.Second, "1.2s"},
+		{time.Minute, "0.02m"},
+		{time.Hour, "0.0003333333333333333h"},
+		{3 * time.Second, "1.2s"},
+	}
+	for _, c := range cases {
+		DurationUnit = c.unit
+		assert.Equal(t, c.expect, d.String(), "unit %v", c.unit)
+	}
+}
+
+func TestDuration_consistentRendering(t *testing.T) {
+	defer func(unit time.Duration) { DurationUnit = unit }(DurationUnit)
+	DurationUnit = time.Millisecond
+	name, value := DurationField("took", 1500*time.Microsecond)
+	scene := Scene{Fields: Fields{name: value}}
+
+	buffer := &bytes.Buffer{}
+	WriteScene(buffer, scene)
+	assert.Equal(t, "<took:1.5ms>", buffer.String())
+
+	buffer.Reset()
+	WriteSceneJSON(buffer, scene)
+	assert.Equal(t, `{"took":"1.5ms"}`, buffer.String())
+
+	buffer.Reset()
+	WriteSceneLogfmt(buffer, scene)
+	assert.Equal(t, "took=1.5ms", buffer.String())
+
+	assert.Equal(t, "1.5ms", FormatFieldValue(value))
+}
@@ -0,0 +1,106 @@
+package scribe
+
+import (
+	"fmt"
+	"time"
+)
+
+// FieldsBuilder provides a fluent, type-safe means of constructing Fields. Each method adds a field and returns the
+// builder, so that calls may be chained; for example —
+//
+//	fields := scribe.NewFields().Str("user", name).Int("attempt", 3).Err(err).Build()
+//
+// Keys are validated as they are added; the first invalid key is reported by TryBuild, or causes Build to panic.
+// If a key is added more than once, the later value wins.
+type FieldsBuilder interface {
+	Str(key string, value string) FieldsBuilder
+	Int(key string, value int) FieldsBuilder
+	Int64(key string, value int64) FieldsBuilder
+	Float64(key string, value float64) FieldsBuilder
+	Bool(key string, value bool) FieldsBuilder
+	Duration(key string, value time.Duration) FieldsBuilder
+	Time(key string, value time.Time) FieldsBuilder
+	Any(key string, value interface{}) FieldsBuilder
+	Err(err error) FieldsBuilder
+	TryBuild() (Fields, error)
+	Build() Fields
+}
+
+type fieldsBuilder struct {
+	fields Fields
+	err    error
+}
+
+// NewFields creates a new, empty FieldsBuilder.
+func NewFields() FieldsBuilder {
+	return &fieldsBuilder{fields: Fields{}}
+}
+
+func (b *fieldsBuilder) put(key string, value interface{}) FieldsBuilder {
+	if key == "" {
+		if b.err == nil {
+			b.err = fmt.Errorf("empty key for field with value %v", value)
+		}
+		return b
+	}
+	b.fields[key] = value
+	return b
+}
+
+// Str adds a string field.
+func (b *fieldsBuilder) Str(key string, value string) FieldsBuilder { return b.put(key, value) }
+
+// Int adds an int field.
+func (b *fieldsBuilder) Int(key string, value int) FieldsBuilder { return b.put(key, value) }
+
+// Int64 adds an int64 field.
+func (b *fieldsBuilder) Int64(key string, value int64) FieldsBuilder { return b.put(key, value) }
+
+// Float64 adds a float64 field.
+func (b *fieldsBuilder) Float64(key string, value float64) FieldsBuilder { return b.put(key, value) }
+
+// Bool adds a bool field.
+func (b *fieldsBuilder) Bool(key string, value bool) FieldsBuilder { return b.put(key, value) }
+
+// Duration adds a time.Duration field.
+func (b *fieldsBuilder) Duration(key string, value time.Duration) FieldsBuilder {
+	return b.put(key, value)
+}
+
+// Time adds a time.Time field.
+func (b *fieldsBuilder) Time(key string, value time.Time) FieldsBuilder { return b.put(key, value) }
+
+// Any adds a field of an arbitrary type.
+func (b *fieldsBuilder) Any(key string, value interface{}) FieldsBuilder { return b.put(key, value) }
+
+// Err adds the given error as a field keyed under ErrorFieldKey. A nil error is ignored.
+//
+// Note: where the error is the subject of the log entry, prefer assigning it to Scene.Err, which bindings render
+// natively.
+func (b *fieldsBuilder) Err(err error) FieldsBuilder {
+	if err == nil {
+		return b
+	}
+	return b.put(ErrorFieldKey, err)
+}
+
+// TryBuild returns the built Fields, or an error if an invalid key was added.
+func (b *fieldsBuilder) TryBuild() (Fields, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	fields := make(Fields, len(b.fields))
+	for k, v := range b.fields {
+		fields[k] = v
+	}
+	return fields, nil
+}
+
+// Build returns the built Fields, panicking if an invalid key was added.
+func (b *fieldsBuilder) Build() Fields {
+	fields, err := b.TryBuild()
+	if err != nil {
+		panic(err)
+	}
+	return fields
+}
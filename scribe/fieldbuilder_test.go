@@ -0,0 +1,60 @@
+package scribe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/obsidiandynamics/libstdgo/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldsBuilder(t *testing.T) {
+	now := time.Now()
+	fields := NewFields().
+		Str("str", "value").
+		Int("int", 1).
+		Int64("int64", 2).
+		Float64("float64", 3.5).
+		Bool("bool", true).
+		Duration("duration", time.Second).
+		Time("time", now).
+		Any("any", []string{"a"}).
+		Err(check.ErrSimulated).
+		Err(nil).
+		Build()
+
+	assert.Equal(t, Fields{
+		"str":         "value",
+		"int":         1,
+		"int64":       int64(2),
+		"float64":     3.5,
+		"bool":        true,
+		"duration":    time.Second,
+		"time":        now,
+		"any":         []string{"a"},
+		ErrorFieldKey: check.ErrSimulated,
+	}, fields)
+}
+
+func TestFieldsBuilder_laterValueWins(t *testing.T) {
+	assert.Equal(t, Fields{"k": 2}, NewFields().Str("k", "1").Int("k", 2).Build())
+}
+
+func TestFieldsBuilder_buildIsolated(t *testing.T) {
+	b := NewFields().Str("a", "1")
+	first := b.Build()
+	b.Str("b", "2")
+	assert.Equal(t, Fields{"a": "1"}, first)
+	assert.Equal(t, Fields{"a": "1", "b": "2"}, b.Build())
+}
+
+func TestFieldsBuilder_emptyKeyRejected(t *testing.T) {
+	b := NewFields().Str("a", "1").Int("", 42).Str("", "later")
+	fields, err := b.TryBuild()
+	assert.Nil(t, fields)
+	assert.EqualError(t, err, "empty key for field with value 42")
+
+	check.ThatPanicsAsExpected(t, check.ErrorWithValue("empty key for field with value 42"), func() {
+		b.Build()
+	})
+}
@@ -0,0 +1,50 @@
+package scribe
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// FieldFormatter renders a field value of a specific type as a string.
+type FieldFormatter func(value interface{}) string
+
+var fieldFormatters = struct {
+	sync.RWMutex
+	byType map[reflect.Type]FieldFormatter
+}{byType: map[reflect.Type]FieldFormatter{}}
+
+// RegisterFieldFormatter registers a formatter for field values that have the same dynamic type as the given
+// example, replacing any formatter previously registered for that type. Registered formatters are consulted by
+// WriteScene; values of unregistered types are rendered using fmt.Sprint. For example, to render timestamps in
+// RFC 3339 form:
+//
+//	scribe.RegisterFieldFormatter(time.Time{}, func(value interface{}) string {
+//		return value.(time.Time).Format(time.RFC3339)
+//	})
+//
+// Passing a nil fn removes the formatter for the example's type, restoring the default rendering.
+//
+// The registry is global and safe for concurrent use; formatters are typically registered during application
+// initialisation.
+func RegisterFieldFormatter(example interface{}, fn FieldFormatter) {
+	fieldFormatters.Lock()
+	defer fieldFormatters.Unlock()
+	if fn == nil {
+		delete(fieldFormatters.byType, reflect.TypeOf(example))
+	} else {
+		fieldFormatters.byType[reflect.TypeOf(example)] = fn
+	}
+}
+
+// FormatFieldValue renders a field value using the formatter registered for its type, falling back to fmt.Sprint
+// if no formatter has been registered.
+func FormatFieldValue(value interface{}) string {
+	fieldFormatters.RLock()
+	fn, ok := fieldFormatters.byType[reflect.TypeOf(value)]
+	fieldFormatters.RUnlock()
+	if ok {
+		return fn(value)
+	}
+	return fmt.Sprint(value)
+}
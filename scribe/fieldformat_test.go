@@ -0,0 +1,41 @@
+package scribe
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterFieldFormatter(t *testing.T) {
+	timestamp := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	assert.Equal(t, timestamp.String(), FormatFieldValue(timestamp))
+
+	RegisterFieldFormatter(time.Time{}, func(value interface{}) string {
+		return value.(time.Time).Format(time.RFC3339)
+	})
+	defer RegisterFieldFormatter(time.Time{}, nil)
+
+	assert.Equal(t, "2020-01-02T03:04:05Z", FormatFieldValue(timestamp))
+	assert.Equal(t, "42", FormatFieldValue(42))
+
+	buffer := &bytes.Buffer{}
+	WriteScene(buffer, Scene{Fields: Fields{"at": timestamp}})
+	assert.Equal(t, "<at:2020-01-02T03:04:05Z>", buffer.String())
+
+	// Deregistering restores the default rendering.
+	RegisterFieldFormatter(time.Time{}, nil)
+	assert.Equal(t, timestamp.String(), FormatFieldValue(timestamp))
+}
+
+func TestRegisterFieldFormatter_exactTypeMatch(t *testing.T) {
+	RegisterFieldFormatter([]byte{}, func(value interface{}) string {
+		return string(value.([]byte))
+	})
+	defer RegisterFieldFormatter([]byte{}, nil)
+
+	assert.Equal(t, "hello", FormatFieldValue([]byte("hello")))
+	bytes := []byte("hello")
+	assert.NotEqual(t, "hello", FormatFieldValue(&bytes))
+}
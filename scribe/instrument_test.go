@@ -0,0 +1,56 @@
+package scribe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstrument(t *testing.T) {
+	if TraceCompiledOut {
+		t.Skip("T() and D() are compiled out by the scribe_notrace tag")
+	}
+
+	slow := Fac(func(format string, args ...interface{}) {
+		time.Sleep(1 * time.Millisecond)
+	})
+	facs, stats := Instrument(LoggerFactories{All: slow, Error: slow})
+	s := New(facs)
+	s.SetEnabled(Debug)
+
+	s.T()("Trace")
+	s.D()("Debug")
+	s.I()("Info")
+	s.I()("Info")
+	s.Capture(Scene{Fields: Fields{"foo": "bar"}}).E()("Error")
+
+	assert.Equal(t, LevelStats{}, stats.Get(Trace))
+	assert.Equal(t, int64(1), stats.Get(Debug).Calls)
+	assert.Equal(t, int64(2), stats.Get(Info).Calls)
+	assert.Equal(t, int64(0), stats.Get(Warn).Calls)
+	assert.Equal(t, int64(1), stats.Get(Error).Calls)
+	assert.GreaterOrEqual(t, int64(stats.Get(Info).Elapsed), int64(2*time.Millisecond))
+	assert.Greater(t, int64(stats.Get(Error).Elapsed), int64(0))
+
+	snapshot := stats.Snapshot()
+	assert.Len(t, snapshot, 6)
+	assert.Equal(t, stats.Get(Info), snapshot[Info])
+	assert.Contains(t, stats.String(), "Calls=2")
+	assert.Contains(t, stats.Get(Info).String(), "LevelStats[Calls=2")
+
+	stats.Reset()
+	assert.Equal(t, LevelStats{}, stats.Get(Info))
+}
+
+func TestInstrument_customLevel(t *testing.T) {
+	const custom Level = 45
+	const uninstrumented Level = 75
+	facs, stats := Instrument(LoggerFactories{custom: Fac(Nop)})
+	facs[custom](custom, Scene{})("custom")
+	facs[custom](uninstrumented, Scene{})("uninstrumented")
+
+	assert.Equal(t, int64(1), stats.Get(custom).Calls)
+	assert.Equal(t, LevelStats{}, stats.Get(uninstrumented))
+	assert.Len(t, stats.Snapshot(), 1)
+}
@@ -0,0 +1,24 @@
+package scribe
+
+import "fmt"
+
+type lazy func() string
+
+// String invokes the underlying function to produce the message.
+func (l lazy) String() string {
+	return l()
+}
+
+// Lazy wraps a message-producing function in a fmt.Stringer, deferring the (potentially expensive) construction of
+// the message until it is formatted. Because loggers for disabled levels are no-ops that never format their
+// arguments, the function is only invoked if the message is actually emitted. For example:
+//
+//	s.D()("%s", scribe.Lazy(func() string {
+//		return expensiveDump(state)
+//	}))
+//
+// Note: the function is invoked each time the message is formatted; where a log call is fanned out to multiple
+// loggers, it may be invoked more than once.
+func Lazy(fn func() string) fmt.Stringer {
+	return lazy(fn)
+}
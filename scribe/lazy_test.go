@@ -0,0 +1,33 @@
+package scribe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLazy(t *testing.T) {
+	invocations := 0
+	msg := Lazy(func() string {
+		invocations++
+		return "expensive"
+	})
+
+	m := NewMock()
+	s := New(m.Factories())
+	s.SetEnabled(Info)
+
+	// Disabled level — the message is never constructed.
+	s.D()("%s", msg)
+	s.Capture(Scene{}).T()("%s", msg)
+	Nop("%s", msg)
+	assert.Equal(t, 0, invocations)
+	m.Entries().Assert(t, Count(0))
+
+	// Enabled level — the message is constructed when formatted.
+	s.I()("%s", msg)
+	m.Entries().Assert(t, Count(1))
+	assert.Equal(t, 0, invocations)
+	assert.Equal(t, "expensive", m.Entries().List()[0].FormattedMessage())
+	assert.Equal(t, 1, invocations)
+}
@@ -58,6 +58,8 @@ func (b *binding) Factories() scribe.LoggerFactories {
 }
 
 // KeyErr is used to key Scene.Err into the custom context.
+//
+// Deprecated: the binding now keys errors using scribe.ErrorFieldKey, which defaults to the same value.
 const KeyErr = "Err"
 
 func buildContext(scene scribe.Scene) []interface{} {
@@ -65,20 +67,21 @@ func buildContext(scene scribe.Scene) []interface{} {
 	if scene.Err != nil {
 		errCount = 1
 	}
-	length := (len(scene.Fields) + errCount) * 2
+	fields := scene.OrderedFields()
+	length := (len(fields) + errCount) * 2
 	if length == 0 {
 		return nil
 	}
 
 	ctx := make([]interface{}, length)
 	i := 0
-	for k, v := range scene.Fields {
-		ctx[i] = k
-		ctx[i+1] = v
+	for _, attr := range fields {
+		ctx[i] = attr.Key
+		ctx[i+1] = attr.Value
 		i += 2
 	}
 	if scene.Err != nil {
-		ctx[i] = KeyErr
+		ctx[i] = scribe.ErrorFieldKey
 		ctx[i+1] = scene.Err
 	}
 	return ctx
@@ -11,6 +11,10 @@ import (
 )
 
 func TestLogLevels(t *testing.T) {
+	if scribe.TraceCompiledOut {
+		t.Skip("T() and D() are compiled out by the scribe_notrace tag")
+	}
+
 	buffer := &bytes.Buffer{}
 	ctor := WithHandler(WithContext(log15.Root()), log15.StreamHandler(buffer, FullFormat{}))
 	binding := Bind(ctor)
@@ -98,3 +102,13 @@ func TestDestructor(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, dtorInvoked)
 }
+
+func TestWithScene_requestID(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	ctor := WithHandler(WithContext(log15.Root()), log15.StreamHandler(buffer, FullFormat{}))
+	s := scribe.New(Bind(ctor).Factories())
+
+	s.Capture(scribe.Scene{}.WithRequestID("req-1")).
+		I()("Charlie %d", 3)
+	assert.Contains(t, buffer.String(), "request_id=req-1")
+}
@@ -22,9 +22,10 @@ type logAPI interface {
 	Errorf(format string, args ...interface{})
 }
 
+// Errors are captured using WithError, which keys them under logrus.ErrorKey rather than scribe.ErrorFieldKey.
 func enrich(api logAPI, scene scribe.Scene) logAPI {
-	if len(scene.Fields) > 0 {
-		api = api.WithFields((lr.Fields)(scene.Fields))
+	if fields := scene.AllFields(); len(fields) > 0 {
+		api = api.WithFields((lr.Fields)(fields))
 	}
 	if scene.Ctx != nil {
 		api = api.WithContext(scene.Ctx)
@@ -72,3 +73,28 @@ func Bind(logger ...*lr.Logger) scribe.LoggerFactories {
 		},
 	}
 }
+
+var levels = map[scribe.Level]lr.Level{
+	scribe.Trace: lr.TraceLevel,
+	scribe.Debug: lr.DebugLevel,
+	scribe.Info:  lr.InfoLevel,
+	scribe.Warn:  lr.WarnLevel,
+	scribe.Error: lr.ErrorLevel,
+}
+
+// Reporter creates a LevelReporter for an optional logger, defaulting to StandardLogger, so that Scribe can skip
+// constructing loggers for the levels that are disabled in Logrus. It should be given the same logger as Bind:
+//
+//	logger := logrus.New()
+//	s := scribe.New(Bind(logger), Reporter(logger))
+//
+// Changes to the Logrus level take effect immediately. Levels without a Logrus counterpart are reported as enabled.
+func Reporter(logger ...*lr.Logger) scribe.LevelReporter {
+	l := arity.SoleUntyped(lr.StandardLogger(), logger).(*lr.Logger)
+	return scribe.LevelReporterFunc(func(level scribe.Level) bool {
+		if lrLevel, ok := levels[level]; ok {
+			return l.IsLevelEnabled(lrLevel)
+		}
+		return true
+	})
+}
@@ -12,6 +12,10 @@ import (
 )
 
 func TestLogLevels_traceEnabled(t *testing.T) {
+	if scribe.TraceCompiledOut {
+		t.Skip("T() and D() are compiled out by the scribe_notrace tag")
+	}
+
 	buffer := &bytes.Buffer{}
 	lr := logrus.New()
 	lr.SetOutput(buffer)
@@ -128,3 +132,53 @@ func TestWithScene_context(t *testing.T) {
 	assert.NotNil(t, h.entry)
 	assert.Equal(t, h.entry.Context, ctx)
 }
+
+func TestWithScene_requestID(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	lr := logrus.New()
+	lr.SetOutput(buffer)
+	s := scribe.New(Bind(lr))
+
+	s.Capture(scribe.Scene{}.WithRequestID("req-1")).
+		I()("Charlie %d", 3)
+	assert.Contains(t, buffer.String(), "request_id=req-1")
+}
+
+func TestReporter(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	lr := logrus.New()
+	lr.SetOutput(buffer)
+	lr.SetLevel(logrus.WarnLevel)
+
+	constructed := map[scribe.Level]int{}
+	facs := Bind(lr)
+	for level, fac := range facs {
+		level, fac := level, fac
+		facs[level] = func(l scribe.Level, scene scribe.Scene) scribe.Logger {
+			constructed[level]++
+			return fac(l, scene)
+		}
+	}
+	s := scribe.New(facs, Reporter(lr))
+	s.SetEnabled(scribe.All)
+
+	s.I()("Charlie %d", 3)
+	s.Capture(scribe.Scene{Fields: scribe.Fields{"x": "y"}}).I()("Charlie %d", 3)
+	assert.Zero(t, constructed[scribe.Info])
+	assert.Empty(t, buffer.String())
+	assert.False(t, s.WouldLog(scribe.Info))
+
+	s.W()("Delta %d", 4)
+	assert.Equal(t, 1, constructed[scribe.Warn])
+	assert.Contains(t, buffer.String(), "Delta 4")
+	assert.True(t, s.WouldLog(scribe.Warn))
+
+	// Changes to the Logrus level are reflected immediately.
+	lr.SetLevel(logrus.InfoLevel)
+	s.I()("Charlie %d", 3)
+	assert.Equal(t, 1, constructed[scribe.Info])
+}
+
+func TestReporter_customLevel(t *testing.T) {
+	assert.True(t, Reporter().LevelEnabled(scribe.Level(45)))
+}
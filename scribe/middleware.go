@@ -0,0 +1,68 @@
+package scribe
+
+// Middleware transforms a Logger, typically by returning a Logger that does something before (or instead of)
+// delegating to next. Unlike a Hook, which is limited to a single transformation per shim, middleware can be composed
+// using Chain and applied to a set of factories using ApplyMiddleware.
+//
+// As with shimming, middleware changes the call site from the perspective of the underlying logger.
+type Middleware func(next Logger) Logger
+
+// SceneMiddleware produces a Middleware for a given level and scene, for transformations that depend on either. It
+// is applied to a set of factories using ApplySceneMiddleware.
+type SceneMiddleware func(level Level, scene Scene) Middleware
+
+// Chain composes the given middleware into one, applied in the order given: the first middleware is the outermost,
+// receiving each log call first, while the last one delegates to the underlying logger. Chaining no middleware
+// produces one that returns the underlying logger unchanged.
+func Chain(mw ...Middleware) Middleware {
+	return func(next Logger) Logger {
+		for i := len(mw) - 1; i >= 0; i-- {
+			next = mw[i](next)
+		}
+		return next
+	}
+}
+
+// ApplyMiddleware applies the given middleware to all factories in facs, returning an equivalent map of factories
+// whose loggers are wrapped in the middleware.
+func ApplyMiddleware(facs LoggerFactories, mw Middleware) LoggerFactories {
+	return ApplySceneMiddleware(facs, func(_ Level, _ Scene) Middleware {
+		return mw
+	})
+}
+
+// ApplySceneMiddleware applies the given scene-aware middleware to all factories in facs, returning an equivalent
+// map of factories. Each logger is wrapped in the middleware produced for its level and scene.
+func ApplySceneMiddleware(facs LoggerFactories, mw SceneMiddleware) LoggerFactories {
+	wrappedFacs := LoggerFactories{}
+	for k, v := range facs {
+		fac := v
+		wrappedFacs[k] = func(level Level, scene Scene) Logger {
+			return mw(level, scene)(fac(level, scene))
+		}
+	}
+	return wrappedFacs
+}
+
+// HookMiddleware adapts a Hook for use as middleware, allowing existing hooks — such as AppendScene — to be
+// composed with other middleware:
+//
+//	facs = scribe.ApplySceneMiddleware(facs, scribe.HookMiddleware(scribe.AppendScene()))
+//
+// The hook may alter the format and args, or Suppress the entry. However, as the scene has already been bound to
+// the underlying logger by the time the middleware runs, changes that the hook makes to the scene are not forwarded;
+// hooks that enrich the scene (such as ProcessFields) must be used with ShimFacs instead.
+func HookMiddleware(hook Hook) SceneMiddleware {
+	return func(level Level, scene Scene) Middleware {
+		return func(next Logger) Logger {
+			return func(format string, args ...interface{}) {
+				scene := scene
+				hook(level, &scene, &format, &args)
+				if format == suppressedFormat {
+					return
+				}
+				next(format, args...)
+			}
+		}
+	}
+}
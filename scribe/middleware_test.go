@@ -0,0 +1,109 @@
+package scribe
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func prefixing(prefix string, order *[]string) Middleware {
+	return func(next Logger) Logger {
+		return func(format string, args ...interface{}) {
+			*order = append(*order, prefix)
+			next(prefix+format, args...)
+		}
+	}
+}
+
+func TestChain_order(t *testing.T) {
+	var order []string
+	m := NewMock()
+	s := New(ApplyMiddleware(m.Factories(), Chain(prefixing("a:", &order), prefixing("b:", &order))))
+
+	s.I()("message %d", 1)
+	assert.Equal(t, []string{"a:", "b:"}, order)
+	m.Entries().Having(LogLevel(Info)).Having(MessageEqual("b:a:message 1")).Assert(t, Count(1))
+}
+
+func TestChain_empty(t *testing.T) {
+	var logged []string
+	logger := Chain()(func(format string, args ...interface{}) {
+		logged = append(logged, fmt.Sprintf(format, args...))
+	})
+	logger("message %d", 1)
+	assert.Equal(t, []string{"message 1"}, logged)
+}
+
+func TestChain_nested(t *testing.T) {
+	var order []string
+	m := NewMock()
+	inner := Chain(prefixing("b:", &order), prefixing("c:", &order))
+	s := New(ApplyMiddleware(m.Factories(), Chain(prefixing("a:", &order), inner)))
+
+	s.I()("message")
+	assert.Equal(t, []string{"a:", "b:", "c:"}, order)
+}
+
+func TestApplyMiddleware_retainsScene(t *testing.T) {
+	var order []string
+	m := NewMock()
+	s := New(ApplyMiddleware(m.Factories(), prefixing("a:", &order)))
+
+	s.Capture(Scene{Fields: Fields{"foo": "bar"}}).W()("message")
+	m.Entries().Having(LogLevel(Warn)).Having(MessageEqual("a:message")).
+		Having(ASceneWith(AField("foo", "bar"))).Assert(t, Count(1))
+}
+
+func TestApplySceneMiddleware(t *testing.T) {
+	m := NewMock()
+	levelTagging := func(level Level, scene Scene) Middleware {
+		return func(next Logger) Logger {
+			return func(format string, args ...interface{}) {
+				next("["+level.String()+"] "+format, args...)
+			}
+		}
+	}
+	s := New(ApplySceneMiddleware(m.Factories(), levelTagging))
+
+	s.E()("message")
+	m.Entries().Having(MessageEqual("[Error] message")).Assert(t, Count(1))
+}
+
+func TestHookMiddleware_appendScene(t *testing.T) {
+	var order []string
+	m := NewMock()
+	s := New(ApplySceneMiddleware(m.Factories(), func(level Level, scene Scene) Middleware {
+		return Chain(prefixing("a:", &order), HookMiddleware(AppendScene())(level, scene))
+	}))
+
+	s.Capture(Scene{Fields: Fields{"foo": "bar"}}).I()("message %d", 1)
+	m.Entries().Having(MessageEqual("a:message 1 <foo:bar>")).Assert(t, Count(1))
+	assert.Equal(t, []string{"a:"}, order)
+}
+
+func TestHookMiddleware_suppress(t *testing.T) {
+	m := NewMock()
+	suppressNoisy := func(level Level, scene *Scene, format *string, args *[]interface{}) {
+		if strings.HasPrefix(*format, "noisy") {
+			Suppress(format)
+		}
+	}
+	s := New(ApplySceneMiddleware(m.Factories(), HookMiddleware(suppressNoisy)))
+
+	s.I()("noisy")
+	s.I()("genuine")
+	m.Entries().Assert(t, Count(1))
+	m.Entries().Having(MessageEqual("genuine")).Assert(t, Count(1))
+}
+
+func TestHookMiddleware_sceneChangesNotRetained(t *testing.T) {
+	m := NewMock()
+	s := New(ApplySceneMiddleware(m.Factories(), HookMiddleware(SequenceField("seq"))))
+
+	logger := s.I()
+	logger("first")
+	logger("second")
+	m.Entries().Having(ASceneWith(AFieldNamed("seq"))).Assert(t, Count(0))
+}
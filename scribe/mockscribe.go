@@ -2,6 +2,7 @@ package scribe
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 	"sync"
 	"time"
@@ -16,6 +17,7 @@ type MockScribe interface {
 	Reset()
 	Entries() Entries
 	ContainsEntries() DynamicAssertion
+	AssertEventually(t check.Tester, timeout time.Duration, preds ...Predicate) bool
 }
 
 // Entry is a single, captured log entry.
@@ -59,8 +61,10 @@ type Entries interface {
 type entries []Entry
 
 type mockScribe struct {
-	lock    sync.Mutex
-	entries entries
+	lock     sync.Mutex
+	entries  entries
+	now      func() time.Time
+	reporter LevelReporter
 }
 
 // NewMock creates a new MockScribe. The returning instance cannot be used to log directly — only to inspect and assert captures.
@@ -68,7 +72,29 @@ type mockScribe struct {
 //  mock := scribe.NewMock()
 //	scribe := scribe.New(mock.Factories())
 func NewMock() MockScribe {
-	return &mockScribe{}
+	return NewMockWithClock(time.Now)
+}
+
+// NewMockWithClock creates a new MockScribe that stamps each captured Entry with the time given by the supplied
+// now function, rather than time.Now. This allows tests to control entry timestamps.
+func NewMockWithClock(now func() time.Time) MockScribe {
+	return &mockScribe{now: now}
+}
+
+// NewMockRespectingLevel creates a new MockScribe whose factories consult the given LevelReporter, returning Nop for
+// any level that the reporter deems disabled, so that calls at that level are not captured. The reporter is consulted
+// each time a factory is invoked, and so may track a threshold that changes over time.
+//
+// A Scribe already withholds disabled levels from its factories, so this is not needed merely to test SetEnabled.
+// Rather, it stands in for a binding that gates levels of its own accord — for example, when the factories are
+// invoked directly by code under test, or to verify that the same reporter, supplied to New, spares the factories:
+//
+//	threshold := scribe.Info
+//	reporter := scribe.LevelReporterFunc(func(level scribe.Level) bool { return level >= threshold })
+//	mock := scribe.NewMockRespectingLevel(reporter)
+//	s := scribe.New(mock.Factories(), reporter)
+func NewMockRespectingLevel(reporter LevelReporter) MockScribe {
+	return &mockScribe{now: time.Now, reporter: reporter}
 }
 
 /*
@@ -76,6 +102,11 @@ Implemented methods.
 */
 
 // Factories obtains the necessary LoggerFactories to configure Scribe.
+//
+// Unless the mock was created with NewMockRespectingLevel, the factories themselves capture at every level
+// unconditionally; it is the Scribe that consults its enabled level, substituting a no-op logger for disabled levels
+// before any factory is invoked. Consequently, log calls made via a Scribe at a disabled level are not captured, and
+// tests may assert on the effect of SetEnabled directly.
 func (s *mockScribe) Factories() LoggerFactories {
 	facs := LoggerFactories{}
 
@@ -86,9 +117,12 @@ func (s *mockScribe) Factories() LoggerFactories {
 
 		level := l.Level
 		facs[level] = func(level Level, scene Scene) Logger {
+			if s.reporter != nil && !s.reporter.LevelEnabled(level) {
+				return Nop
+			}
 			return func(format string, args ...interface{}) {
 				s.append(Entry{
-					Timestamp: time.Now(),
+					Timestamp: s.now(),
 					Level:     level,
 					Format:    format,
 					Args:      args,
@@ -136,7 +170,7 @@ func (e entries) Having(p Predicate) Entries {
 func (e entries) Assert(t check.Tester, a Assertion) Entries {
 	msg := a(e)
 	if msg != nil {
-		t.Errorf("%s%s", *msg, check.PrintStack(2))
+		t.Errorf("%s%s", *msg, check.PrintStack(2+check.StackOffset))
 	}
 	return e
 }
@@ -194,6 +228,22 @@ func MessageEqual(expected string) Predicate {
 	}
 }
 
+// ArgEqual matches entries where the argument at the given (zero-based) position equals the expected value, as
+// per reflect.DeepEqual. The argument is compared before formatting, so its type must match exactly — for example,
+// int(42) is not equal to int64(42). Entries with fewer arguments do not match.
+func ArgEqual(index int, value interface{}) Predicate {
+	return func(e Entry) bool {
+		return index >= 0 && index < len(e.Args) && reflect.DeepEqual(e.Args[index], value)
+	}
+}
+
+// ArgCount matches entries that were logged with exactly n arguments.
+func ArgCount(n int) Predicate {
+	return func(e Entry) bool {
+		return len(e.Args) == n
+	}
+}
+
 // Not produces a logical inverse of a predicate.
 func Not(p Predicate) Predicate {
 	return func(e Entry) bool {
@@ -228,6 +278,13 @@ func AFieldNamed(name string) ScenePredicate {
 	}
 }
 
+// ARequestID is satisfied if the scene carries the given request ID.
+func ARequestID(id string) ScenePredicate {
+	return func(scene Scene) bool {
+		return scene.RequestID == id
+	}
+}
+
 // AnError is satisfied if the scene holds an error.
 func AnError() ScenePredicate {
 	return func(scene Scene) bool {
@@ -285,6 +342,20 @@ func CountAtMost(maximum int) Assertion {
 	}
 }
 
+// NoneMatching ensures that no entry satisfies the given predicate. On failure, the message of the first offending
+// entry is reported.
+func NoneMatching(p Predicate) Assertion {
+	return func(e Entries) *string {
+		for _, entry := range e.List() {
+			if p(entry) {
+				msg := fmt.Sprintf("Expected no matching entries; got '%s' at level %s", entry.FormattedMessage(), entry.Level)
+				return &msg
+			}
+		}
+		return nil
+	}
+}
+
 /*
 Dynamic assertions.
 */
@@ -309,6 +380,34 @@ func (s DynamicAssertion) Having(p Predicate) DynamicAssertion {
 	return s
 }
 
+// AssertEventually waits until at least one captured entry satisfies all of the given predicates, up to the given
+// timeout, returning true if a matching entry was found. On timeout, the test is failed with a listing of the
+// entries captured at that point. It is a convenience for the common case of
+//
+//	check.Wait(t, timeout).UntilAsserted(mock.ContainsEntries().Having(p).Passes(scribe.CountAtLeast(1)))
+func (s *mockScribe) AssertEventually(t check.Tester, timeout time.Duration, preds ...Predicate) bool {
+	return check.Wait(t, timeout).UntilAsserted(func(t check.Tester) {
+		all := s.Entries()
+		matching := all
+		for _, p := range preds {
+			matching = matching.Having(p)
+		}
+		if matching.Length() == 0 {
+			t.Errorf("Expected an entry satisfying %d predicate(s); captured %d entries:%s",
+				len(preds), all.Length(), listEntries(all))
+		}
+	})
+}
+
+func listEntries(e Entries) string {
+	builder := strings.Builder{}
+	for _, entry := range e.List() {
+		builder.WriteString("\n\t")
+		builder.WriteString(entry.String())
+	}
+	return builder.String()
+}
+
 // Passes returns an Assertion that is evaluated against a refreshed Entries snapshot.
 func (s DynamicAssertion) Passes(a Assertion) check.Assertion {
 	return func(t check.Tester) {
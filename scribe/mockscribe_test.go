@@ -11,6 +11,10 @@ import (
 )
 
 func TestBasicLogging(t *testing.T) {
+	if TraceCompiledOut {
+		t.Skip("T() and D() are compiled out by the scribe_notrace tag")
+	}
+
 	m := NewMock()
 	l := New(m.Factories())
 	l.SetEnabled(All)
@@ -54,6 +58,10 @@ func TestBasicLogging(t *testing.T) {
 }
 
 func TestSceneLogging(t *testing.T) {
+	if TraceCompiledOut {
+		t.Skip("T() and D() are compiled out by the scribe_notrace tag")
+	}
+
 	m := NewMock()
 	l := New(m.Factories())
 	l.SetEnabled(All)
@@ -164,6 +172,35 @@ func TestDynamicAssertions(t *testing.T) {
 	a2(t)
 }
 
+func TestAssertEventually(t *testing.T) {
+	m := NewMock()
+	l := New(m.Factories())
+
+	go func() {
+		time.Sleep(time.Millisecond)
+		l.I()("Info %d", 1)
+		l.E()("Error %d", 2)
+	}()
+
+	c := check.NewTestCapture()
+	assert.True(t, m.AssertEventually(c, 10*time.Second, LogLevel(Error), MessageEqual("Error 2")))
+	c.First().AssertNil(t)
+}
+
+func TestAssertEventually_timeout(t *testing.T) {
+	m := NewMock()
+	l := New(m.Factories())
+	l.I()("Info %d", 1)
+	l.E()("Error %d", 2)
+
+	c := check.NewTestCapture()
+	assert.False(t, m.AssertEventually(c, time.Millisecond, LogLevel(Error), MessageEqual("Error 1")))
+	assert.Equal(t, 1, c.Length())
+	c.First().AssertContains(t, "Expected an entry satisfying 2 predicate(s); captured 2 entries:")
+	c.First().AssertContains(t, "Format=Info %d, Args=[1]")
+	c.First().AssertContains(t, "Format=Error %d, Args=[2]")
+}
+
 func TestMultithreadedLogging(t *testing.T) {
 	m := NewMock()
 	l := New(m.Factories())
@@ -196,6 +233,10 @@ func TestMultithreadedLogging(t *testing.T) {
 }
 
 func TestRest(t *testing.T) {
+	if TraceCompiledOut {
+		t.Skip("T() and D() are compiled out by the scribe_notrace tag")
+	}
+
 	m := NewMock()
 	l := New(m.Factories())
 	l.SetEnabled(All)
@@ -211,6 +252,10 @@ func TestRest(t *testing.T) {
 }
 
 func TestAssertionFailures(t *testing.T) {
+	if TraceCompiledOut {
+		t.Skip("T() and D() are compiled out by the scribe_notrace tag")
+	}
+
 	m := NewMock()
 	l := New(m.Factories())
 	l.SetEnabled(All)
@@ -237,4 +282,104 @@ func TestAssertionFailures(t *testing.T) {
 	c.First().AssertFirstLineEqual(t, "Expected at least 7 entries; got 5")
 	assert.Equal(t, 2, c.First().NumCapturedLines())
 	c.Reset()
+
+	m.Entries().Assert(c, NoneMatching(MessageContaining("%")))
+	assert.Equal(t, 0, c.Length())
+
+	m.Entries().Assert(c, NoneMatching(LogLevel(Warn)))
+	c.First().AssertFirstLineEqual(t, "Expected no matching entries; got 'Warn 6 7' at level Warn")
+	assert.Equal(t, 2, c.First().NumCapturedLines())
+	c.Reset()
+}
+
+func TestNewMockWithClock(t *testing.T) {
+	base := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	ticks := 0
+	m := NewMockWithClock(func() time.Time {
+		ticks++
+		return base.Add(time.Duration(ticks) * time.Second)
+	})
+	l := New(m.Factories())
+
+	l.I()("first")
+	l.W()("second")
+	list := m.Entries().List()
+	assert.Len(t, list, 2)
+	assert.Equal(t, base.Add(time.Second), list[0].Timestamp)
+	assert.Equal(t, base.Add(2*time.Second), list[1].Timestamp)
+}
+
+func TestDisabledLevelsNotCaptured(t *testing.T) {
+	m := NewMock()
+	l := New(m.Factories())
+	l.SetEnabled(Warn)
+
+	l.T()("Trace")
+	l.D()("Debug")
+	l.I()("Info")
+	l.L(Info)("Info")
+	l.Capture(Scene{Fields: Fields{"foo": "bar"}}).I()("Info")
+	m.Entries().Assert(t, Count(0))
+
+	l.W()("Warn")
+	l.Capture(Scene{Fields: Fields{"foo": "bar"}}).E()("Error")
+	m.Entries().Assert(t, Count(2))
+	m.Entries().Assert(t, NoneMatching(LogLevel(Info)))
+
+	l.SetEnabled(Off)
+	l.E()("Error")
+	m.Entries().Assert(t, Count(2))
+}
+
+func TestArgPredicates(t *testing.T) {
+	m := NewMock()
+	l := New(m.Factories())
+
+	l.I()("Processed %s in %d ms", "alpha", 10)
+	l.I()("Processed %s in %d ms", "bravo", 20)
+	l.I()("Processed %s in %d ms", "alpha", int64(30))
+	l.I()("Processed %v", []string{"charlie"})
+	l.I()("No args")
+
+	m.Entries().Having(ArgEqual(0, "alpha")).Assert(t, Count(2))
+	m.Entries().Having(ArgEqual(0, "alpha")).Having(ArgEqual(1, 10)).Assert(t, Count(1))
+	m.Entries().Having(ArgEqual(1, 30)).Assert(t, Count(0))
+	m.Entries().Having(ArgEqual(1, int64(30))).Assert(t, Count(1))
+	m.Entries().Having(ArgEqual(0, []string{"charlie"})).Assert(t, Count(1))
+	m.Entries().Having(ArgEqual(2, "alpha")).Assert(t, Count(0))
+	m.Entries().Having(ArgEqual(-1, "alpha")).Assert(t, Count(0))
+
+	m.Entries().Having(ArgCount(2)).Assert(t, Count(3))
+	m.Entries().Having(ArgCount(1)).Assert(t, Count(1))
+	m.Entries().Having(ArgCount(0)).Having(MessageEqual("No args")).Assert(t, Count(1))
+}
+
+func TestNewMockRespectingLevel(t *testing.T) {
+	threshold := Info
+	m := NewMockRespectingLevel(LevelReporterFunc(func(level Level) bool {
+		return level >= threshold
+	}))
+
+	// Invoking the factories directly bypasses any Scribe; the mock gates levels by itself.
+	facs := m.Factories()
+	facs[Debug](Debug, Scene{})("Debug")
+	facs[Info](Info, Scene{})("Info")
+	m.Entries().Assert(t, Count(1))
+	m.Entries().Having(LogLevel(Info)).Assert(t, Count(1))
+
+	// The reporter is consulted on each invocation.
+	threshold = Debug
+	facs[Debug](Debug, Scene{})("Debug")
+	m.Entries().Having(LogLevel(Debug)).Assert(t, Count(1))
+
+	// Via a Scribe with everything enabled, the mock still withholds levels that the reporter disables.
+	m.Reset()
+	threshold = Warn
+	l := New(facs)
+	l.SetEnabled(All)
+	l.L(Trace)("Trace")
+	l.I()("Info")
+	l.Capture(Scene{Fields: Fields{"foo": "bar"}}).W()("Warn")
+	m.Entries().Assert(t, Count(1))
+	m.Entries().Having(LogLevel(Warn)).Having(ASceneWith(AField("foo", "bar"))).Assert(t, Count(1))
 }
@@ -0,0 +1,8 @@
+//go:build scribe_notrace
+// +build scribe_notrace
+
+package scribe
+
+// TraceCompiledOut is true if the package was built with the 'scribe_notrace' tag, in which case the short-form T()
+// and D() methods return Nop directly. It is false in regular builds.
+const TraceCompiledOut = true
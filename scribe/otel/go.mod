@@ -0,0 +1,11 @@
+module github.com/obsidiandynamics/libstdgo/scribe/otel
+
+go 1.16
+
+require (
+	github.com/obsidiandynamics/libstdgo v0.0.0-00010101000000-000000000000
+	github.com/stretchr/testify v1.7.0
+	go.opentelemetry.io/otel/trace v1.4.1
+)
+
+replace github.com/obsidiandynamics/libstdgo => ../..
@@ -0,0 +1,44 @@
+// Package otel provides an OpenTelemetry integration for Scribe, enriching log entries with the identifiers of the
+// active span.
+//
+// This package is a separate module (github.com/obsidiandynamics/libstdgo/scribe/otel), so that neither the core
+// packages nor their consumers take on the OpenTelemetry dependency, or its minimum Go version of 1.16.
+package otel
+
+import (
+	"github.com/obsidiandynamics/libstdgo/scribe"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TraceIDFieldKey is the field name under which TraceContext injects the trace ID.
+var TraceIDFieldKey = "trace_id"
+
+// SpanIDFieldKey is the field name under which TraceContext injects the span ID.
+var SpanIDFieldKey = "span_id"
+
+// TraceContext is a hook that extracts the span context from the scene's Ctx, injecting the trace and span IDs
+// (in their hex form) as fields. Scenes without a context, or whose context does not carry a valid span
+// context, are left untouched. Fields already present in the scene take precedence.
+//
+// Use with scribe.ShimFacs:
+//
+//	s := scribe.New(scribe.ShimFacs(facs, otel.TraceContext()))
+func TraceContext() scribe.Hook {
+	return func(level scribe.Level, scene *scribe.Scene, format *string, args *[]interface{}) {
+		if scene.Ctx == nil {
+			return
+		}
+		spanCtx := trace.SpanContextFromContext(scene.Ctx)
+		if !spanCtx.IsValid() {
+			return
+		}
+
+		fields := make(scribe.Fields, len(scene.Fields)+2)
+		fields[TraceIDFieldKey] = spanCtx.TraceID().String()
+		fields[SpanIDFieldKey] = spanCtx.SpanID().String()
+		for k, v := range scene.Fields {
+			fields[k] = v
+		}
+		scene.Fields = fields
+	}
+}
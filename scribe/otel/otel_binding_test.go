@@ -0,0 +1,58 @@
+package otel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/obsidiandynamics/libstdgo/scribe"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	fakeTraceID = trace.TraceID{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10}
+	fakeSpanID  = trace.SpanID{0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11}
+)
+
+func fakeSpanContext() context.Context {
+	return trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    fakeTraceID,
+		SpanID:     fakeSpanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+}
+
+func TestTraceContext(t *testing.T) {
+	m := scribe.NewMock()
+	s := scribe.New(scribe.ShimFacs(m.Factories(), TraceContext()))
+
+	s.Capture(scribe.Scene{Ctx: fakeSpanContext(), Fields: scribe.Fields{"foo": "bar"}}).I()("traced")
+
+	m.Entries().Having(scribe.MessageEqual("traced")).
+		Having(scribe.ASceneWith(scribe.AField(TraceIDFieldKey, "0102030405060708090a0b0c0d0e0f10"))).
+		Having(scribe.ASceneWith(scribe.AField(SpanIDFieldKey, "0a0b0c0d0e0f1011"))).
+		Having(scribe.ASceneWith(scribe.AField("foo", "bar"))).
+		Assert(t, scribe.Count(1))
+}
+
+func TestTraceContext_existingFieldsTakePrecedence(t *testing.T) {
+	scene := scribe.Scene{Ctx: fakeSpanContext(), Fields: scribe.Fields{TraceIDFieldKey: "custom"}}
+	format := "msg"
+	args := []interface{}{}
+	TraceContext()(scribe.Info, &scene, &format, &args)
+	assert.Equal(t, scribe.Fields{TraceIDFieldKey: "custom", SpanIDFieldKey: "0a0b0c0d0e0f1011"}, scene.Fields)
+}
+
+func TestTraceContext_noSpanContext(t *testing.T) {
+	m := scribe.NewMock()
+	s := scribe.New(scribe.ShimFacs(m.Factories(), TraceContext()))
+
+	s.I()("no scene")
+	s.Capture(scribe.Scene{Ctx: context.Background(), Fields: scribe.Fields{"foo": "bar"}}).I()("untraced")
+
+	m.Entries().Having(scribe.ASceneWith(scribe.AFieldNamed(TraceIDFieldKey))).Assert(t, scribe.Count(0))
+	m.Entries().Having(scribe.ASceneWith(scribe.AFieldNamed(SpanIDFieldKey))).Assert(t, scribe.Count(0))
+	m.Entries().Having(scribe.MessageEqual("untraced")).
+		Having(scribe.ASceneWith(scribe.AField("foo", "bar"))).
+		Assert(t, scribe.Count(1))
+}
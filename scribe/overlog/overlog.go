@@ -6,9 +6,12 @@ package overlog
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"reflect"
+	"strings"
 	"sync"
 	"time"
 
@@ -41,6 +44,11 @@ type Event struct {
 	Message   string
 	Level     scribe.Level
 	Scene     scribe.Scene
+
+	// Format and Args are the original printf-style arguments from which Message was rendered, for formatters
+	// that need to re-render or structure them.
+	Format string
+	Args   []interface{}
 }
 
 // Formatter specifies how a logging event should be rendered in a given output buffer.
@@ -110,6 +118,147 @@ func Scene() Formatter {
 	}
 }
 
+// ScenePretty is a variant of the Scene formatter that prints each field of the scene on its own line, following the
+// message and prefixed with the given indent, in the form 'key: value'. Fields are printed in the order given by
+// Scene.OrderedFields, followed by the error (if set) keyed under scribe.ErrorFieldKey. For example —
+//
+//	overlog.New(overlog.Format(overlog.Level(), overlog.Message(), overlog.ScenePretty("  ")))
+//
+// yields records such as
+//
+//	INF Request served
+//	  method: GET
+//	  path: /health
+//
+// The record remains a single write to the underlying writer, so that the lines of concurrently logged records are
+// never interleaved. However, as it breaks the convention of one record per line, this formatter is intended for
+// local development only; line-oriented log shippers will treat each field as a separate record.
+func ScenePretty(indent string) Formatter {
+	return func(buffer *bytes.Buffer, event Event) {
+		for _, attr := range event.Scene.OrderedFields() {
+			fmt.Fprintf(buffer, "\n%s%s: %s", indent, attr.Key, scribe.FormatFieldValue(attr.Value))
+		}
+		if event.Scene.Err != nil {
+			fmt.Fprintf(buffer, "\n%s%s: %s", indent, scribe.ErrorFieldKey, event.Scene.Err.Error())
+		}
+	}
+}
+
+// EscapeNewlines wraps the given formatter such that any carriage returns and newlines in its output are replaced
+// with the escape sequences '\r' and '\n', respectively. This ensures that each log record occupies a single
+// physical line, as expected by line-oriented log shippers, even where the message or the scene contains
+// embedded line breaks. For example —
+//
+//	overlog.New(overlog.EscapeNewlines(overlog.StandardFormat()))
+//
+// Note: backslashes are not escaped; a message containing a literal '\n' cannot be distinguished from one
+// containing an escaped newline.
+func EscapeNewlines(formatter Formatter) Formatter {
+	return func(buffer *bytes.Buffer, event Event) {
+		start := buffer.Len()
+		formatter(buffer, event)
+		escaped := newlineEscaper.Replace(string(buffer.Bytes()[start:]))
+		buffer.Truncate(start)
+		buffer.WriteString(escaped)
+	}
+}
+
+var newlineEscaper = strings.NewReplacer("\r", `\r`, "\n", `\n`)
+
+// StackTrace is a formatter that, for entries at the Error level (or coarser) whose scene carries a stack-bearing
+// error, appends the frames of the error's stack trace. An error is deemed stack-bearing if it has a StackTrace
+// method taking no arguments and returning a single slice or fmt.Formatter, as do the errors created by the
+// github.com/pkg/errors package; the returned value is printed using the '%+v' verb, which renders one frame per line in the case of
+// pkg/errors. The first error in the chain (as per errors.Unwrap) that bears a stack is used. For entries at finer
+// levels, or where the error does not carry a stack trace, this formatter has no effect.
+//
+// The method is located by reflection, so that Overlog need not depend on any particular errors package.
+//
+// Because the stack trace spans multiple lines, this formatter should be placed last.
+func StackTrace() Formatter {
+	return func(buffer *bytes.Buffer, event Event) {
+		if event.Level < scribe.Error {
+			return
+		}
+		for err := event.Scene.Err; err != nil; err = errors.Unwrap(err) {
+			if stackTrace, ok := stackTraceOf(err); ok {
+				fmt.Fprintf(buffer, "%+v", stackTrace)
+				return
+			}
+		}
+	}
+}
+
+// Obtains the stack trace of the given error by invoking its StackTrace method, if it has a suitable one. A nil
+// pointer is not deemed stack-bearing, as invoking the method on it would likely panic; nor is an error whose
+// StackTrace method returns anything other than a slice or a fmt.Formatter, as the result would not print as
+// a stack trace.
+func stackTraceOf(err error) (interface{}, bool) {
+	value := reflect.ValueOf(err)
+	if value.Kind() == reflect.Ptr && value.IsNil() {
+		return nil, false
+	}
+	method := value.MethodByName("StackTrace")
+	if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+		return nil, false
+	}
+	if out := method.Type().Out(0); out.Kind() != reflect.Slice && !out.Implements(formatterType) {
+		return nil, false
+	}
+	return method.Call(nil)[0].Interface(), true
+}
+
+var formatterType = reflect.TypeOf((*fmt.Formatter)(nil)).Elem()
+
+// SceneIncluding is a variant of the Scene formatter that prints only the named fields, omitting all others. The
+// request ID and the error are treated as fields keyed under scribe.RequestIDFieldKey and scribe.ErrorFieldKey,
+// respectively, and so are printed only if named. (If the error is printed, its chain accompanies it when
+// scribe.RenderErrorChain is set.)
+func SceneIncluding(keys ...string) Formatter {
+	included := keySet(keys)
+	return func(buffer *bytes.Buffer, event Event) {
+		scribe.WriteScene(buffer, filterScene(event.Scene, func(key string) bool { return included[key] }))
+	}
+}
+
+// SceneExcluding is a variant of the Scene formatter that prints all fields except those named — for example, to
+// suppress a sensitive field. As with SceneIncluding, the request ID and the error may be excluded by naming
+// scribe.RequestIDFieldKey and scribe.ErrorFieldKey, respectively. (Excluding the error also excludes its chain.)
+func SceneExcluding(keys ...string) Formatter {
+	excluded := keySet(keys)
+	return func(buffer *bytes.Buffer, event Event) {
+		scribe.WriteScene(buffer, filterScene(event.Scene, func(key string) bool { return !excluded[key] }))
+	}
+}
+
+func keySet(keys []string) map[string]bool {
+	set := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		set[key] = true
+	}
+	return set
+}
+
+// Produces a scene comprising only those fields (including the request ID) and the error that satisfy the
+// given predicate. The error chain, if rendered, is not filtered in its own right; rather, it is regenerated
+// from the error, and so is kept if and only if the error is.
+func filterScene(scene scribe.Scene, keep func(key string) bool) scribe.Scene {
+	renderedChain := scribe.RenderErrorChain && len(scribe.ErrorChain(scene.Err)) > 0
+	filtered := scribe.Scene{}
+	for _, attr := range scene.OrderedFields() {
+		if renderedChain && attr.Key == scribe.ErrorChainFieldKey {
+			continue
+		}
+		if keep(attr.Key) {
+			filtered.Attrs = append(filtered.Attrs, attr)
+		}
+	}
+	if keep(scribe.ErrorFieldKey) {
+		filtered.Err = scene.Err
+	}
+	return filtered
+}
+
 // New creates a synchronized logger backed by a given writer. If unspecified, os.Stdout will
 // be used.
 func New(formatter Formatter, writer ...io.Writer) Overlog {
@@ -124,7 +273,14 @@ func (o *overlog) With(level scribe.Level, scene scribe.Scene) scribe.Logger {
 	return func(format string, args ...interface{}) {
 		msg := fmt.Sprintf(format, args...)
 		buffer := &bytes.Buffer{}
-		o.formatter(buffer, Event{time.Now(), msg, level, scene})
+		o.formatter(buffer, Event{
+			Timestamp: time.Now(),
+			Message:   msg,
+			Level:     level,
+			Scene:     scene,
+			Format:    format,
+			Args:      args,
+		})
 		fmt.Fprintln(buffer)
 
 		o.lock.Lock()
@@ -1,6 +1,10 @@
 package overlog
 
-import "github.com/obsidiandynamics/libstdgo/scribe"
+import (
+	"bytes"
+
+	"github.com/obsidiandynamics/libstdgo/scribe"
+)
 
 // Bind creates a direct binding for the given logger.
 func Bind(logger Overlog) scribe.LoggerFactories {
@@ -8,3 +12,14 @@ func Bind(logger Overlog) scribe.LoggerFactories {
 		scribe.All: logger.With,
 	}
 }
+
+// NewBuffered is a convenience for tests, creating a Scribe that writes to a buffer using an Overlog with the
+// StandardFormat, and returning both the Scribe and the buffer to assert against. The Scribe has the default
+// enabled level.
+//
+// Writes to the buffer are synchronized by the Overlog, but reads are not; the buffer should only be inspected
+// once logging has ceased.
+func NewBuffered() (scribe.Scribe, *bytes.Buffer) {
+	buffer := &bytes.Buffer{}
+	return scribe.New(Bind(New(StandardFormat(), buffer))), buffer
+}
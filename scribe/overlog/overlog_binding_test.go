@@ -10,6 +10,10 @@ import (
 )
 
 func TestLogLevels(t *testing.T) {
+	if scribe.TraceCompiledOut {
+		t.Skip("T() and D() are compiled out by the scribe_notrace tag")
+	}
+
 	buffer := &bytes.Buffer{}
 	logger := New(StandardFormat(), buffer)
 	s := scribe.New(Bind(logger))
@@ -39,3 +43,11 @@ func TestLogLevels(t *testing.T) {
 	assert.Contains(t, buffer.String(), "ERR Echo 5 <foo:bar> <simulated>")
 	buffer.Reset()
 }
+
+func TestNewBuffered(t *testing.T) {
+	s, buffer := NewBuffered()
+	assert.Equal(t, scribe.DefaultEnabledLevel, s.Enabled())
+
+	s.Capture(scribe.Scene{Fields: scribe.Fields{"foo": "bar"}}).I()("Alpha %d", 1)
+	assert.Contains(t, buffer.String(), "INF Alpha 1 <foo:bar>\n")
+}
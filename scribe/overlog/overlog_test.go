@@ -2,6 +2,8 @@ package overlog
 
 import (
 	"bytes"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -100,9 +102,224 @@ func TestScene(t *testing.T) {
 	assert.Equal(t, "<foo:bar> <simulated>\n", b.String())
 }
 
+func TestScene_customErrorFieldKey(t *testing.T) {
+	defer func(key string) { scribe.ErrorFieldKey = key }(scribe.ErrorFieldKey)
+	scribe.ErrorFieldKey = "error"
+	defer func(render bool) { scribe.RenderErrorKey = render }(scribe.RenderErrorKey)
+	scribe.RenderErrorKey = true
+
+	b := &bytes.Buffer{}
+	s := New(Scene(), b)
+	s.With(scribe.Info, scribe.Scene{Err: check.ErrSimulated})("irrelevant")
+	assert.Equal(t, "<error:simulated>\n", b.String())
+}
+
+func TestSceneIncluding(t *testing.T) {
+	b := &bytes.Buffer{}
+	s := New(SceneIncluding("request_id", "user"), b)
+	scene := scribe.Scene{Fields: scribe.Fields{"user": "joe", "password": "secret"}, Err: check.ErrSimulated}
+	s.With(scribe.Info, scene.WithRequestID("req-1"))("irrelevant")
+	s.With(scribe.Info, scribe.Scene{Fields: scribe.Fields{"password": "secret"}})("irrelevant")
+	assert.Contains(t, []string{"<request_id:req-1 user:joe>\n\n", "<user:joe request_id:req-1>\n\n"}, b.String())
+
+	b.Reset()
+	s = New(SceneIncluding(scribe.ErrorFieldKey), b)
+	s.With(scribe.Info, scene)("irrelevant")
+	assert.Equal(t, "<simulated>\n", b.String())
+}
+
+func TestSceneExcluding(t *testing.T) {
+	b := &bytes.Buffer{}
+	s := New(SceneExcluding("password", scribe.ErrorFieldKey), b)
+	scene := scribe.Scene{Fields: scribe.Fields{"user": "joe", "password": "secret"}, Err: check.ErrSimulated}
+	s.With(scribe.Info, scene)("irrelevant")
+	assert.Equal(t, "<user:joe>\n", b.String())
+
+	b.Reset()
+	s = New(SceneExcluding("password"), b)
+	s.With(scribe.Info, scene.WithRequestID("req-1"))("irrelevant")
+	assert.Contains(t, []string{"<request_id:req-1 user:joe> <simulated>\n", "<user:joe request_id:req-1> <simulated>\n"},
+		b.String())
+}
+
+func TestSceneExcluding_errorChain(t *testing.T) {
+	defer func(render bool) { scribe.RenderErrorChain = render }(scribe.RenderErrorChain)
+	scribe.RenderErrorChain = true
+
+	b := &bytes.Buffer{}
+	s := New(SceneExcluding(scribe.ErrorFieldKey), b)
+	scene := scribe.Scene{Fields: scribe.Fields{"user": "joe"}, Err: fmt.Errorf("outer: %w", check.ErrSimulated)}
+	s.With(scribe.Info, scene)("irrelevant")
+	assert.Equal(t, "<user:joe>\n", b.String())
+
+	b.Reset()
+	s = New(SceneExcluding(), b)
+	s.With(scribe.Info, scene)("irrelevant")
+	assert.Contains(t, []string{"<err_chain:[simulated] user:joe> <outer: simulated>\n",
+		"<user:joe err_chain:[simulated]> <outer: simulated>\n"}, b.String())
+
+	b.Reset()
+	s = New(SceneIncluding("user"), b)
+	s.With(scribe.Info, scene)("irrelevant")
+	assert.Equal(t, "<user:joe>\n", b.String())
+}
+
+// A stack trace that renders in the manner of pkg/errors, with a leading newline before each frame.
+type fakeStackTrace []string
+
+func (st fakeStackTrace) Format(s fmt.State, verb rune) {
+	for _, frame := range st {
+		fmt.Fprintf(s, "\n%s", frame)
+	}
+}
+
+// An error that bears a stack trace, in the manner of pkg/errors.
+type stackBearingError struct {
+	stackTrace fakeStackTrace
+}
+
+func (e stackBearingError) Error() string {
+	return "stack-bearing"
+}
+
+func (e stackBearingError) StackTrace() fakeStackTrace {
+	return e.stackTrace
+}
+
+// An error with a StackTrace method that does not conform to the expected signature.
+type nonconformingError struct{}
+
+func (e nonconformingError) Error() string {
+	return "nonconforming"
+}
+
+func (e nonconformingError) StackTrace(depth int) fakeStackTrace {
+	return fakeStackTrace{"unexpected"}
+}
+
+// An error with a StackTrace method whose result would not print as a stack trace.
+type stringStackError struct{}
+
+func (e stringStackError) Error() string {
+	return "string stack"
+}
+
+func (e stringStackError) StackTrace() string {
+	return "unexpected"
+}
+
+// A stack-bearing error with pointer receivers, which panic if invoked on a nil pointer.
+type pointerStackError struct {
+	stackTrace fakeStackTrace
+}
+
+func (e *pointerStackError) Error() string {
+	return "pointer stack"
+}
+
+func (e *pointerStackError) StackTrace() fakeStackTrace {
+	return e.stackTrace
+}
+
+func TestStackTrace_stackBearingError(t *testing.T) {
+	b := &bytes.Buffer{}
+	s := New(Format(Level(), Message(), StackTrace()), b)
+	err := fmt.Errorf("wrapped: %w", stackBearingError{fakeStackTrace{"main.run\n\tmain.go:10", "main.main\n\tmain.go:5"}})
+
+	s.With(scribe.Error, scribe.Scene{Err: err})("failed")
+	assert.Equal(t, "ERR failed\nmain.run\n\tmain.go:10\nmain.main\n\tmain.go:5\n", b.String())
+
+	b.Reset()
+	s.With(scribe.Warn, scribe.Scene{Err: err})("not an error")
+	assert.Equal(t, "WRN not an error\n", b.String())
+}
+
+func TestStackTrace_plainError(t *testing.T) {
+	b := &bytes.Buffer{}
+	s := New(Format(Level(), Message(), StackTrace()), b)
+	s.With(scribe.Error, scribe.Scene{Err: check.ErrSimulated})("failed")
+	s.With(scribe.Error, scribe.Scene{Err: nonconformingError{}})("nonconforming")
+	s.With(scribe.Error, scribe.Scene{Err: stringStackError{}})("string stack")
+	s.With(scribe.Error, scribe.Scene{})("no error")
+	assert.Equal(t, "ERR failed\nERR nonconforming\nERR string stack\nERR no error\n", b.String())
+}
+
+func TestStackTrace_nilPointerError(t *testing.T) {
+	b := &bytes.Buffer{}
+	s := New(Format(Level(), Message(), StackTrace()), b)
+	check.ThatDoesNotPanic(t, func() {
+		s.With(scribe.Error, scribe.Scene{Err: (*pointerStackError)(nil)})("nil pointer")
+	})
+	s.With(scribe.Error, scribe.Scene{Err: &pointerStackError{fakeStackTrace{"main.main"}}})("pointer")
+	assert.Equal(t, "ERR nil pointer\nERR pointer\nmain.main\n", b.String())
+}
+
+func TestScenePretty(t *testing.T) {
+	b := &bytes.Buffer{}
+	s := New(Format(Level(), Message(), ScenePretty("  ")), b)
+	s.With(scribe.Info, scribe.Scene{
+		Attrs:  scribe.Attrs{{Key: "method", Value: "GET"}, {Key: "path", Value: "/health"}},
+		Fields: scribe.Fields{"bytes": 42},
+		Err:    check.ErrSimulated,
+	})("Request %s", "served")
+	s.Infof("next")
+	assert.Equal(t, "INF Request served\n"+
+		"  method: GET\n"+
+		"  path: /health\n"+
+		"  bytes: 42\n"+
+		"  Err: simulated\n"+
+		"INF next\n", b.String())
+}
+
+func TestScenePretty_emptyScene(t *testing.T) {
+	b := &bytes.Buffer{}
+	s := New(Format(Message(), ScenePretty("\t")), b)
+	s.With(scribe.Info, scribe.Scene{})("plain")
+	s.With(scribe.Info, scribe.Scene{RequestID: "req-1"})("with request ID")
+	assert.Equal(t, "plain\nwith request ID\n\trequest_id: req-1\n", b.String())
+}
+
+func TestEscapeNewlines(t *testing.T) {
+	b := &bytes.Buffer{}
+	s := New(Format(Level(), EscapeNewlines(Format(Message(), Scene()))), b)
+	s.With(scribe.Info, scribe.Scene{Fields: scribe.Fields{"foo": "multi\nline"}})("first\r\nsecond\nthird")
+	s.Infof("next")
+	assert.Equal(t, "INF first\\r\\nsecond\\nthird <foo:multi\\nline>\nINF next\n", b.String())
+	assert.Len(t, strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n"), 2)
+}
+
+func TestEscapeNewlines_withStackTrace(t *testing.T) {
+	b := &bytes.Buffer{}
+	s := New(EscapeNewlines(Format(Message(), StackTrace())), b)
+	s.With(scribe.Error, scribe.Scene{Err: stackBearingError{fakeStackTrace{"main.main"}}})("failed")
+	assert.True(t, strings.HasPrefix(b.String(), "failed\\n"))
+	assert.Equal(t, 1, strings.Count(b.String(), "\n"))
+}
+
 func TestFormat(t *testing.T) {
 	b := &bytes.Buffer{}
 	s := New(Format(Level(), Message()), b)
 	s.With(scribe.Info, scribe.Scene{})("important message %d", 42)
 	assert.Equal(t, "INF important message 42\n", b.String())
 }
+
+func TestCustomFormatter_rawFormatAndArgs(t *testing.T) {
+	b := &bytes.Buffer{}
+	var captured Event
+	s := New(func(buffer *bytes.Buffer, event Event) {
+		captured = event
+		Append(buffer, event.Format)
+	}, b)
+	s.With(scribe.Info, scribe.Scene{})("important message %d", 42)
+	assert.Equal(t, "important message %d\n", b.String())
+	assert.Equal(t, "important message %d", captured.Format)
+	assert.Equal(t, []interface{}{42}, captured.Args)
+	assert.Equal(t, "important message 42", captured.Message)
+}
+
+func TestScene_requestID(t *testing.T) {
+	b := &bytes.Buffer{}
+	s := New(Format(Message(), Scene()), b)
+	s.With(scribe.Info, scribe.Scene{}.WithRequestID("req-1"))("handled")
+	assert.Equal(t, "handled <request_id:req-1>\n", b.String())
+}
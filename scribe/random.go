@@ -0,0 +1,101 @@
+package scribe
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Characters from which random strings are drawn, deliberately including whitespace, quotes, separators, format
+// verbs and multi-byte runes, so as to exercise escaping in formatters.
+var randomRunes = []rune("abcXYZ019 _-=:<>{}[],\"'\\%\t\n\u00e9\u4e16\U0001F600")
+
+const maxRandomFields = 6
+
+// RandomScene generates a random (but valid) Scene using the given source of randomness. The generated scene has
+// a varying number of fields with values of assorted types (strings, integers, floats — including non-finite
+// ones — booleans, nil and errors), and optionally an error and a context. It is intended for fuzzing and
+// property-based testing of formatters and bindings.
+func RandomScene(r *rand.Rand) Scene {
+	scene := Scene{}
+	if numFields := r.Intn(maxRandomFields + 1); numFields > 0 {
+		scene.Fields = make(Fields, numFields)
+		for i := 0; i < numFields; i++ {
+			scene.Fields[randomString(r)] = randomValue(r)
+		}
+	}
+	if r.Intn(2) == 0 {
+		scene.Err = errors.New(randomString(r))
+	}
+	if r.Intn(2) == 0 {
+		scene.Ctx = context.Background()
+	}
+	return scene
+}
+
+// RandomEntry generates a random Entry using the given source of randomness, logged at one of the built-in levels,
+// with a random scene (as per RandomScene). The format string contains a %v verb for each of the generated
+// arguments (any other percent signs are escaped), such that FormattedMessage does not produce formatting errors.
+func RandomEntry(r *rand.Rand) Entry {
+	numArgs := r.Intn(4)
+	args := make([]interface{}, numArgs)
+	format := strings.ReplaceAll(randomString(r), "%", "%%")
+	for i := range args {
+		args[i] = randomValue(r)
+		format += " %v"
+	}
+
+	return Entry{
+		Timestamp: time.Unix(0, r.Int63()),
+		Level:     randomLevel(r),
+		Format:    format,
+		Args:      args,
+		Scene:     RandomScene(r),
+	}
+}
+
+func randomLevel(r *rand.Rand) Level {
+	levels := make([]Level, 0, len(Levels))
+	for _, l := range Levels {
+		if l.Level != All && l.Level != Off {
+			levels = append(levels, l.Level)
+		}
+	}
+	// Map iteration order is random, so the levels are sorted by ordinal to keep the outcome deterministic for a
+	// given source.
+	sort.Slice(levels, func(i, j int) bool { return levels[i] < levels[j] })
+	return levels[r.Intn(len(levels))]
+}
+
+func randomString(r *rand.Rand) string {
+	runes := make([]rune, r.Intn(12))
+	for i := range runes {
+		runes[i] = randomRunes[r.Intn(len(randomRunes))]
+	}
+	return string(runes)
+}
+
+func randomValue(r *rand.Rand) interface{} {
+	switch r.Intn(8) {
+	case 0:
+		return randomString(r)
+	case 1:
+		return r.Int63() - r.Int63()
+	case 2:
+		return r.Intn(1000)
+	case 3:
+		return r.NormFloat64()
+	case 4:
+		return []float64{math.NaN(), math.Inf(1), math.Inf(-1)}[r.Intn(3)]
+	case 5:
+		return r.Intn(2) == 0
+	case 6:
+		return nil
+	default:
+		return errors.New(randomString(r))
+	}
+}
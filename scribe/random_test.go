@@ -0,0 +1,89 @@
+package scribe
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const fuzzIterations = 1000
+
+func TestRandomScene_deterministic(t *testing.T) {
+	for i := int64(0); i < 10; i++ {
+		assert.Equal(t, RandomScene(rand.New(rand.NewSource(i))).String(), RandomScene(rand.New(rand.NewSource(i))).String())
+	}
+}
+
+func TestRandomScene_variety(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	var withFields, withoutFields, withErr, withCtx int
+	for i := 0; i < fuzzIterations; i++ {
+		scene := RandomScene(r)
+		if len(scene.Fields) > 0 {
+			withFields++
+		} else {
+			withoutFields++
+		}
+		if scene.Err != nil {
+			withErr++
+		}
+		if scene.Ctx != nil {
+			withCtx++
+		}
+	}
+	assert.NotZero(t, withFields)
+	assert.NotZero(t, withoutFields)
+	assert.NotZero(t, withErr)
+	assert.NotZero(t, withCtx)
+}
+
+func TestRandomEntry(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	for i := 0; i < fuzzIterations; i++ {
+		entry := RandomEntry(r)
+		assert.NotEqual(t, All, entry.Level)
+		assert.NotEqual(t, Off, entry.Level)
+		assert.NotContains(t, entry.FormattedMessage(), "%!")
+	}
+}
+
+func TestWriteSceneJSON_fuzz(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	for i := 0; i < fuzzIterations; i++ {
+		scene := RandomEntry(r).Scene
+		buffer := &bytes.Buffer{}
+		assert.NotPanics(t, func() {
+			WriteSceneJSON(buffer, scene)
+		})
+
+		out := strings.TrimSpace(buffer.String())
+		if len(scene.Fields) == 0 && scene.Err == nil {
+			assert.Empty(t, out)
+			continue
+		}
+		if assert.True(t, json.Valid([]byte(out)), "Invalid JSON: %s", out) {
+			decoded := map[string]interface{}{}
+			assert.Nil(t, json.Unmarshal([]byte(out), &decoded))
+			assert.Subset(t, keysOf(decoded), keysOf(scene.Fields))
+		}
+	}
+}
+
+func keysOf(m interface{}) []string {
+	keys := make([]string, 0)
+	switch m := m.(type) {
+	case map[string]interface{}:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case Fields:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
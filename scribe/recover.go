@@ -0,0 +1,38 @@
+package scribe
+
+import "runtime/debug"
+
+// StackFieldKey is the field name under which RecoverAndLog emits the stack trace of a recovered panic.
+var StackFieldKey = "stack"
+
+// RecoverAndLog recovers from a panic, logging the recovered value at the Error level along with the stack trace of
+// the panicking goroutine. If rethrow is set, the panic is then resumed with the original value. It must be deferred
+// directly, as in —
+//
+//	defer scribe.RecoverAndLog(s, false)
+//
+// The stack trace is captured as a field keyed under StackFieldKey and, if the recovered value is an error, it is
+// captured as the scene's Err. This requires s to implement SceneCapturer, as does a Scribe and any StdLogAPI
+// obtained from one; where s already has a captured scene, the fields are merged into it. Otherwise, the stack
+// trace is appended to the message. If there is no panic, this function has no effect.
+func RecoverAndLog(s StdLogAPI, rethrow bool) {
+	cause := recover()
+	if cause == nil {
+		return
+	}
+
+	stack := string(debug.Stack())
+	if capturer, ok := s.(SceneCapturer); ok {
+		scene := Scene{Fields: Fields{StackFieldKey: stack}}
+		if err, ok := cause.(error); ok {
+			scene.Err = err
+		}
+		capturer.Capture(scene).E()("Recovered from panic: %v", cause)
+	} else {
+		s.E()("Recovered from panic: %v\n%s", cause, stack)
+	}
+
+	if rethrow {
+		panic(cause)
+	}
+}
@@ -0,0 +1,97 @@
+package scribe
+
+import (
+	"testing"
+
+	"github.com/obsidiandynamics/libstdgo/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func panicWith(s StdLogAPI, rethrow bool, cause interface{}) {
+	defer RecoverAndLog(s, rethrow)
+	panic(cause)
+}
+
+func TestRecoverAndLog_noRethrow(t *testing.T) {
+	m := NewMock()
+	l := New(m.Factories())
+
+	check.ThatDoesNotPanic(t, func() {
+		panicWith(l, false, check.ErrSimulated)
+	})
+	m.Entries().Having(LogLevel(Error)).
+		Having(MessageEqual("Recovered from panic: simulated")).
+		Having(ASceneWith(AFieldNamed(StackFieldKey))).
+		Having(ASceneWith(AnError())).
+		Assert(t, Count(1))
+
+	entry := m.Entries().List()[0]
+	assert.Equal(t, check.ErrSimulated, entry.Scene.Err)
+	assert.Contains(t, entry.Scene.Fields[StackFieldKey], "panicWith")
+}
+
+func TestRecoverAndLog_rethrow(t *testing.T) {
+	m := NewMock()
+	l := New(m.Factories())
+
+	check.ThatPanicsAsExpected(t, check.CauseEqual("boom"), func() {
+		panicWith(l, true, "boom")
+	})
+	m.Entries().Having(LogLevel(Error)).
+		Having(MessageEqual("Recovered from panic: boom")).
+		Having(ASceneWith(AFieldNamed(StackFieldKey))).
+		Assert(t, Count(1))
+}
+
+func TestRecoverAndLog_withCapturedScene(t *testing.T) {
+	m := NewMock()
+	l := New(m.Factories())
+
+	panicWith(l.Capture(Scene{Fields: Fields{"foo": "bar"}}), false, check.ErrSimulated)
+	m.Entries().Having(LogLevel(Error)).
+		Having(MessageEqual("Recovered from panic: simulated")).
+		Having(ASceneWith(AField("foo", "bar"))).
+		Having(ASceneWith(AFieldNamed(StackFieldKey))).
+		Having(ASceneWith(AnError())).
+		Assert(t, Count(1))
+
+	entry := m.Entries().List()[0]
+	assert.Contains(t, entry.Scene.Fields[StackFieldKey], "panicWith")
+}
+
+func TestRecoverAndLog_withRemappedScene(t *testing.T) {
+	m := NewMock()
+	l := RemapLevels(New(m.Factories()), func(level Level, format string) Level { return Warn })
+
+	panicWith(l.Capture(Scene{Fields: Fields{"foo": "bar"}}), false, "boom")
+	m.Entries().Having(LogLevel(Warn)).
+		Having(MessageEqual("Recovered from panic: boom")).
+		Having(ASceneWith(AField("foo", "bar"))).
+		Having(ASceneWith(AFieldNamed(StackFieldKey))).
+		Assert(t, Count(1))
+}
+
+// A StdLogAPI that does not implement SceneCapturer.
+type uncapturingLogAPI struct {
+	StdLogAPI
+}
+
+func TestRecoverAndLog_withoutSceneCapturer(t *testing.T) {
+	m := NewMock()
+	l := New(m.Factories())
+
+	panicWith(uncapturingLogAPI{l}, false, "boom")
+	m.Entries().Having(LogLevel(Error)).
+		Having(MessageContaining("Recovered from panic: boom\n")).
+		Having(MessageContaining("panicWith")).
+		Assert(t, Count(1))
+	assert.NotContains(t, m.Entries().List()[0].Scene.Fields, StackFieldKey)
+}
+
+func TestRecoverAndLog_noPanic(t *testing.T) {
+	m := NewMock()
+	func() {
+		defer RecoverAndLog(New(m.Factories()), true)
+	}()
+	m.Entries().Assert(t, Count(0))
+}
@@ -0,0 +1,116 @@
+package scribe
+
+// LevelRemapper decides the level at which a message should be logged, given the level that was requested by the
+// application and the message format string.
+type LevelRemapper func(level Level, format string) Level
+
+type remappingScribe struct {
+	Scribe
+	remap LevelRemapper
+}
+
+type remappingStub struct {
+	s     Scribe
+	scene Scene
+	remap LevelRemapper
+}
+
+// RemapLevels wraps a Scribe such that the level of each log call is determined by the given remapper, which is
+// invoked with the requested level and the format string at the point of logging. This is useful for taming a noisy
+// library — for example, downgrading specific Error messages to Warn.
+//
+// The remapped level is subject to the usual enablement check, so a message downgraded below the enabled level is
+// discarded. Scenes captured via Capture() are carried through to the logger for the remapped level.
+//
+// Note: because the format string is only known when the logger is invoked, remapping introduces an extra frame
+// into the call stack, which may affect loggers that report the call site.
+func RemapLevels(s Scribe, remap LevelRemapper) Scribe {
+	return &remappingScribe{s, remap}
+}
+
+// Capture contextual scene metadata, returning an API whose loggers are remapped.
+func (r *remappingScribe) Capture(scene Scene) StdLogAPI {
+	return &remappingStub{r.Scribe, scene, r.remap}
+}
+
+// CaptureAttrs captures an ordered list of attributes, returning an API whose loggers are remapped.
+func (r *remappingScribe) CaptureAttrs(attrs ...Attr) StdLogAPI {
+	return r.Capture(Scene{Attrs: attrs})
+}
+
+// L obtains a logger that remaps the supplied level before logging.
+func (r *remappingScribe) L(level Level) Logger {
+	return func(format string, args ...interface{}) {
+		r.Scribe.L(r.remap(level, format))(format, args...)
+	}
+}
+
+// At is an alias for L, reading more naturally at call sites where the level is computed.
+func (r *remappingScribe) At(level Level) Logger { return r.L(level) }
+
+// MsgLogger obtains a logger for pre-formatted messages, logging each at the level chosen by the remapper. As there
+// is no format string, the remapper is given the message in its place. As with L, the level is only known when the
+// logger is invoked, so the returned function adds a frame to the call stack.
+func (r *remappingScribe) MsgLogger(level Level) func(msg string) {
+	return func(msg string) {
+		r.Scribe.L(r.remap(level, msg))("%s", msg)
+	}
+}
+
+// T is the short form of L(Trace), returning a logger for the Trace level.
+func (r *remappingScribe) T() Logger { return r.L(Trace) }
+
+// D is the short form of L(Debug), returning a logger for the Debug level.
+func (r *remappingScribe) D() Logger { return r.L(Debug) }
+
+// I is the short form of L(Info), returning a logger for the Info level.
+func (r *remappingScribe) I() Logger { return r.L(Info) }
+
+// W is the short form of L(Warn), returning a logger for the Warn level.
+func (r *remappingScribe) W() Logger { return r.L(Warn) }
+
+// E is the short form of L(Error), returning a logger for the Error level.
+func (r *remappingScribe) E() Logger { return r.L(Error) }
+
+// WouldLog returns true if a message logged at the given level would be emitted by the underlying Scribe. The check
+// is performed on the requested level, as the remapped level depends on the format string.
+func (rs *remappingStub) WouldLog(level Level) bool {
+	return rs.s.WouldLog(level)
+}
+
+// Capture merges the given scene into the captured scene, returning an API whose loggers are remapped.
+func (rs *remappingStub) Capture(scene Scene) StdLogAPI {
+	return &remappingStub{rs.s, rs.scene.Merge(scene), rs.remap}
+}
+
+func (rs *remappingStub) L(level Level) Logger {
+	return func(format string, args ...interface{}) {
+		rs.s.Capture(rs.scene).L(rs.remap(level, format))(format, args...)
+	}
+}
+
+// At is an alias for L, returning a logger for a dynamically chosen level that retains the captured scene.
+func (rs *remappingStub) At(level Level) Logger { return rs.L(level) }
+
+// MsgLogger obtains a logger for pre-formatted messages, logging each at the level chosen by the remapper and
+// retaining the captured scene.
+func (rs *remappingStub) MsgLogger(level Level) func(msg string) {
+	return func(msg string) {
+		rs.s.Capture(rs.scene).L(rs.remap(level, msg))("%s", msg)
+	}
+}
+
+// T is the short form of L(Trace), returning a logger for the Trace level.
+func (rs *remappingStub) T() Logger { return rs.L(Trace) }
+
+// D is the short form of L(Debug), returning a logger for the Debug level.
+func (rs *remappingStub) D() Logger { return rs.L(Debug) }
+
+// I is the short form of L(Info), returning a logger for the Info level.
+func (rs *remappingStub) I() Logger { return rs.L(Info) }
+
+// W is the short form of L(Warn), returning a logger for the Warn level.
+func (rs *remappingStub) W() Logger { return rs.L(Warn) }
+
+// E is the short form of L(Error), returning a logger for the Error level.
+func (rs *remappingStub) E() Logger { return rs.L(Error) }
@@ -0,0 +1,110 @@
+package scribe
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func downgradeNoisy(level Level, format string) Level {
+	if level == Error && strings.HasPrefix(format, "noisy") {
+		return Warn
+	}
+	return level
+}
+
+func TestRemapLevels(t *testing.T) {
+	m := NewMock()
+	s := RemapLevels(New(m.Factories()), downgradeNoisy)
+	s.SetEnabled(All)
+	assert.Equal(t, All, s.Enabled())
+
+	s.E()("noisy %d", 1)
+	s.E()("genuine %d", 2)
+	s.W()("noisy %d", 3)
+	s.T()("trace")
+	s.D()("debug")
+	s.I()("info")
+	s.L(Error)("noisy %d", 4)
+
+	m.Entries().Having(LogLevel(Error)).Assert(t, Count(1))
+	m.Entries().Having(LogLevel(Error)).Having(MessageEqual("genuine 2")).Assert(t, Count(1))
+	m.Entries().Having(LogLevel(Warn)).Assert(t, Count(3))
+	m.Entries().Having(LogLevel(Trace)).Assert(t, Count(1))
+	m.Entries().Having(LogLevel(Debug)).Assert(t, Count(1))
+	m.Entries().Having(LogLevel(Info)).Assert(t, Count(1))
+}
+
+func TestRemapLevels_withScene(t *testing.T) {
+	m := NewMock()
+	s := RemapLevels(New(m.Factories()), downgradeNoisy)
+	s.SetEnabled(All)
+
+	scene := Scene{Fields: Fields{"foo": "bar"}}
+	s.Capture(scene).E()("noisy %d", 1)
+	s.Capture(scene).E()("genuine %d", 2)
+	s.Capture(scene).T()("trace")
+	s.Capture(scene).D()("debug")
+	s.Capture(scene).I()("info")
+	s.Capture(scene).W()("warn")
+
+	m.Entries().Having(LogLevel(Warn)).Having(ASceneWith(AField("foo", "bar"))).Assert(t, Count(2))
+	m.Entries().Having(LogLevel(Error)).Having(ASceneWith(AField("foo", "bar"))).Assert(t, Count(1))
+	m.Entries().Having(ASceneWith(AField("foo", "bar"))).Assert(t, Count(6))
+}
+
+func TestRemapLevels_belowEnabled(t *testing.T) {
+	m := NewMock()
+	s := RemapLevels(New(m.Factories()), downgradeNoisy)
+	s.SetEnabled(Error)
+
+	s.E()("noisy %d", 1)
+	s.E()("genuine %d", 2)
+
+	m.Entries().Assert(t, Count(1))
+	m.Entries().Having(MessageEqual("genuine 2")).Assert(t, Count(1))
+}
+
+func TestRemapLevels_wouldLog(t *testing.T) {
+	s := RemapLevels(New(NewMock().Factories()), downgradeNoisy)
+	s.SetEnabled(Warn)
+	assert.False(t, s.WouldLog(Info))
+	assert.True(t, s.WouldLog(Error))
+	assert.False(t, s.Capture(Scene{}).WouldLog(Info))
+	assert.True(t, s.Capture(Scene{}).WouldLog(Error))
+}
+
+func TestRemapLevels_at(t *testing.T) {
+	m := NewMock()
+	s := RemapLevels(New(m.Factories()), downgradeNoisy)
+
+	s.At(Error)("noisy %d", 1)
+	s.Capture(Scene{Fields: Fields{"foo": "bar"}}).At(Error)("noisy %d", 2)
+	m.Entries().Having(LogLevel(Warn)).Assert(t, Count(2))
+	m.Entries().Having(ASceneWith(AField("foo", "bar"))).Assert(t, Count(1))
+}
+
+func TestRemapLevels_msg(t *testing.T) {
+	m := NewMock()
+	s := RemapLevels(New(m.Factories()), downgradeNoisy)
+
+	s.MsgLogger(Error)("noisy %s")
+	s.MsgLogger(Error)("genuine %s")
+	s.Capture(Scene{Fields: Fields{"foo": "bar"}}).MsgLogger(Error)("noisy %d")
+	m.Entries().Having(LogLevel(Warn)).Having(MessageEqual("noisy %s")).Assert(t, Count(1))
+	m.Entries().Having(LogLevel(Error)).Having(MessageEqual("genuine %s")).Assert(t, Count(1))
+	m.Entries().Having(LogLevel(Warn)).Having(MessageEqual("noisy %d")).
+		Having(ASceneWith(AField("foo", "bar"))).Assert(t, Count(1))
+}
+
+func TestRemapLevels_captureAttrs(t *testing.T) {
+	m := NewMock()
+	s := RemapLevels(New(m.Factories()), downgradeNoisy)
+
+	s.CaptureAttrs(Attr{"foo", "bar"}).E()("noisy %d", 1)
+	entries := m.Entries().Having(LogLevel(Warn)).List()
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, Attrs{{"foo", "bar"}}, entries[0].Scene.Attrs)
+	}
+}
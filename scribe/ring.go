@@ -0,0 +1,80 @@
+package scribe
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+type ring struct {
+	lock    sync.Mutex
+	entries []Entry
+	next    int
+	full    bool
+}
+
+// RingFactories creates LoggerFactories backed by a fixed-size, in-memory ring buffer that retains the
+// most recent capacity entries, irrespective of their level. Once the buffer is full, each new entry
+// overwrites the oldest one. This is intended for post-mortem diagnostics in production, where the last
+// few log records are dumped on a panic or a crash. (For testing, use MockScribe instead.)
+//
+// Also returned is a drain function, which yields the retained entries in the order they were logged
+// (oldest first), emptying the buffer in the process.
+//
+// The capacity must be greater than zero; otherwise, this function panics.
+func RingFactories(capacity int) (LoggerFactories, func() []Entry) {
+	if capacity < 1 {
+		panic(fmt.Errorf("capacity must be greater than 0"))
+	}
+
+	r := &ring{entries: make([]Entry, capacity)}
+	facs := LoggerFactories{}
+	for _, l := range Levels {
+		if l.Level == Off {
+			continue
+		}
+
+		facs[l.Level] = func(level Level, scene Scene) Logger {
+			return func(format string, args ...interface{}) {
+				r.append(Entry{
+					Timestamp: time.Now(),
+					Level:     level,
+					Format:    format,
+					Args:      args,
+					Scene:     scene,
+				})
+			}
+		}
+	}
+	return facs, r.drain
+}
+
+func (r *ring) append(e Entry) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.entries[r.next] = e
+	r.next++
+	if r.next == len(r.entries) {
+		r.next = 0
+		r.full = true
+	}
+}
+
+func (r *ring) drain() []Entry {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var drained []Entry
+	if r.full {
+		drained = make([]Entry, 0, len(r.entries))
+		drained = append(drained, r.entries[r.next:]...)
+	} else {
+		drained = make([]Entry, 0, r.next)
+	}
+	drained = append(drained, r.entries[:r.next]...)
+
+	r.entries = make([]Entry, len(r.entries))
+	r.next = 0
+	r.full = false
+	return drained
+}
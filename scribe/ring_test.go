@@ -0,0 +1,78 @@
+package scribe
+
+import (
+	"testing"
+
+	"github.com/obsidiandynamics/libstdgo/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRingFactories_belowCapacity(t *testing.T) {
+	if TraceCompiledOut {
+		t.Skip("T() and D() are compiled out by the scribe_notrace tag")
+	}
+
+	facs, drain := RingFactories(3)
+	l := New(facs)
+	l.SetEnabled(All)
+
+	assert.Len(t, drain(), 0)
+
+	l.T()("Trace %d", 0)
+	l.Capture(Scene{Fields: Fields{"foo": "bar"}}).E()("Error %d", 1)
+
+	drained := drain()
+	if assert.Len(t, drained, 2) {
+		assert.Equal(t, Trace, drained[0].Level)
+		assert.Equal(t, "Trace 0", drained[0].FormattedMessage())
+		assert.Equal(t, Error, drained[1].Level)
+		assert.Equal(t, "Error 1", drained[1].FormattedMessage())
+		assert.Equal(t, Fields{"foo": "bar"}, drained[1].Scene.Fields)
+	}
+
+	// Draining empties the buffer.
+	assert.Len(t, drain(), 0)
+}
+
+func TestRingFactories_wrapAround(t *testing.T) {
+	facs, drain := RingFactories(3)
+	l := New(facs)
+
+	for i := 0; i < 7; i++ {
+		l.I()("Info %d", i)
+	}
+
+	drained := drain()
+	if assert.Len(t, drained, 3) {
+		assert.Equal(t, "Info 4", drained[0].FormattedMessage())
+		assert.Equal(t, "Info 5", drained[1].FormattedMessage())
+		assert.Equal(t, "Info 6", drained[2].FormattedMessage())
+	}
+
+	// Log after draining a wrapped buffer.
+	l.W()("Warn %d", 7)
+	drained = drain()
+	if assert.Len(t, drained, 1) {
+		assert.Equal(t, "Warn 7", drained[0].FormattedMessage())
+	}
+}
+
+func TestRingFactories_exactCapacity(t *testing.T) {
+	facs, drain := RingFactories(2)
+	l := New(facs)
+
+	l.I()("Info %d", 0)
+	l.I()("Info %d", 1)
+
+	drained := drain()
+	if assert.Len(t, drained, 2) {
+		assert.Equal(t, "Info 0", drained[0].FormattedMessage())
+		assert.Equal(t, "Info 1", drained[1].FormattedMessage())
+	}
+}
+
+func TestRingFactories_invalidCapacity(t *testing.T) {
+	check.ThatPanicsAsExpected(t, check.ErrorContaining("capacity must be greater than 0"), func() {
+		RingFactories(0)
+	})
+}
@@ -0,0 +1,43 @@
+package scribe
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// SampleByLevel is a hook that probabilistically discards entries, retaining each entry with the probability
+// configured for its level. Rates are in the range [0, 1]; a level that is not listed is retained at a rate of 1
+// (i.e. always). For example, the following retains 1% of Trace entries, 10% of Debug entries and all others:
+//
+//	SampleByLevel(map[Level]float64{Trace: 0.01, Debug: 0.1})
+//
+// Sampling is applied via Suppress, and so takes effect when the hook is used with ShimFacs. The random number
+// generator is seeded from the clock and is safe for concurrent use. This function panics if a rate is out of range.
+func SampleByLevel(rates map[Level]float64) Hook {
+	levelRates := make(map[Level]float64, len(rates))
+	for level, rate := range rates {
+		if rate < 0 || rate > 1 {
+			panic(fmt.Errorf("sampling rate for level %s must be in the range [0, 1]; got %v", level, rate))
+		}
+		levelRates[level] = rate
+	}
+
+	var lock sync.Mutex
+	random := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	return func(level Level, scene *Scene, format *string, args *[]interface{}) {
+		rate, ok := levelRates[level]
+		if !ok || rate == 1 {
+			return
+		}
+
+		lock.Lock()
+		sample := random.Float64()
+		lock.Unlock()
+		if sample >= rate {
+			Suppress(format)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package scribe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/obsidiandynamics/libstdgo/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSampleByLevel(t *testing.T) {
+	m := NewMock()
+	l := New(ShimFacs(m.Factories(), SampleByLevel(map[Level]float64{Trace: 0.01, Debug: 0.1, Warn: 1, Error: 0})))
+	l.SetEnabled(All)
+
+	const iterations = 100_000
+	for i := 0; i < iterations; i++ {
+		for _, level := range []Level{Trace, Debug, Info, Warn, Error} {
+			l.L(level)("message %d", i)
+		}
+	}
+
+	retained := func(level Level) float64 {
+		return float64(m.Entries().Having(LogLevel(level)).Length()) / iterations
+	}
+	assert.InDelta(t, 0.01, retained(Trace), 0.005)
+	assert.InDelta(t, 0.1, retained(Debug), 0.01)
+	assert.Equal(t, 1.0, retained(Info))
+	assert.Equal(t, 1.0, retained(Warn))
+	assert.Equal(t, 0.0, retained(Error))
+}
+
+func TestSampleByLevel_invalidRate(t *testing.T) {
+	check.ThatPanicsAsExpected(t, check.ErrorWithValue("sampling rate for level Debug must be in the range [0, 1]; got 1.5"), func() {
+		SampleByLevel(map[Level]float64{Debug: 1.5})
+	})
+}
+
+func TestSuppress(t *testing.T) {
+	captured := make([]string, 0)
+	facs := LoggerFactories{All: Fac(func(format string, args ...interface{}) {
+		captured = append(captured, fmt.Sprintf(format, args...))
+	})}
+	dropOdd := func(level Level, scene *Scene, format *string, args *[]interface{}) {
+		if (*args)[0].(int)%2 == 1 {
+			Suppress(format)
+		}
+	}
+
+	l := New(ShimFacs(facs, dropOdd))
+	for i := 0; i < 4; i++ {
+		l.I()("message %d", i)
+	}
+	assert.Equal(t, []string{"message 0", "message 2"}, captured)
+}
@@ -13,12 +13,21 @@ preserved. (By contrast, a naive façade/adapter/shim injects itself into the ca
 file name and line number to the logger.)
 
 Scribe is thread-safe; multiple goroutines may use the same instance.
+
+For latency-critical builds, the 'scribe_notrace' build tag (e.g. 'go build -tags scribe_notrace') compiles out the
+short-form T() and D() methods, which then return Nop without consulting the factories or the enabled level. Calls
+made via L(Trace) and L(Debug) are unaffected. The TraceCompiledOut constant reports whether the tag is in effect.
 */
 package scribe
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/obsidiandynamics/libstdgo/arity"
 )
 
 // Level of logging. The lowest ordinal corresponds to the most fine-grained level. By convention, a level
@@ -125,26 +134,210 @@ func ParseLevelName(name string) (LevelSpec, error) {
 	return LevelSpec{}, fmt.Errorf("no level specification for name '%s'", name)
 }
 
+// ErrorFieldKey is the key under which Scene.Err is rendered by the bindings that lack a native notion of an
+// error, as well as by WriteScene. It may be reassigned to suit an organisation's field naming conventions, but
+// should be set before any logging takes place.
+//
+// WriteScene only renders the key if RenderErrorKey is set.
+var ErrorFieldKey = "Err"
+
+// RenderErrorKey, when set, causes WriteScene to prefix Scene.Err with ErrorFieldKey, as in '<Err:simulated>'.
+// It is disabled by default for compatibility with existing log output, in which the error is written on its own,
+// as in '<simulated>'. It should be set before any logging takes place.
+var RenderErrorKey = false
+
+// RenderErrorChain, when set, causes the chain of errors wrapped by Scene.Err (as obtained by repeatedly applying
+// errors.Unwrap) to be emitted as a list field keyed under ErrorChainFieldKey, in addition to the top-level error.
+// It is disabled by default, and should be set before any logging takes place.
+var RenderErrorChain = false
+
+// ErrorChainFieldKey is the field name under which the error chain is emitted when RenderErrorChain is set.
+var ErrorChainFieldKey = "err_chain"
+
+// RequestIDFieldKey is the field name under which bindings emit Scene.RequestID. As with ErrorFieldKey, it may be
+// reassigned, but should be set before any logging takes place.
+var RequestIDFieldKey = "request_id"
+
 // Fields is a free-form set of attributes that can be captured as part of a Scene, supporting
 // log enrichment and structured logging.
 type Fields map[string]interface{}
 
 // Scene captures additional metadata from the call site that is forwarded to the logger. This is used to
 // facilitate structured logging, pass contexts onto loggers, communicate application errors, and so forth.
+//
+// Attributes may be given either as Fields or as Attrs; the latter preserves their order. Where a key appears in
+// both, the value in Attrs takes precedence.
 type Scene struct {
-	Fields Fields
-	Ctx    context.Context
-	Err    error
+	Fields    Fields
+	Attrs     Attrs
+	Ctx       context.Context
+	Err       error
+	RequestID string
 }
 
-// String obtains a textual representation of a Scene.
+// RedactedValue is substituted for the values of sensitive fields when rendering a redacted Scene.
+const RedactedValue = "[REDACTED]"
+
+// RedactByDefault, when set, causes Scene.String() to mask any field whose name contains (case-insensitively)
+// one of the SensitiveKeySubstrings. It is disabled by default, and should be set before any logging takes place.
+var RedactByDefault = false
+
+// SensitiveKeySubstrings lists the (lower-case) substrings of field names that are considered sensitive when
+// RedactByDefault is set.
+var SensitiveKeySubstrings = []string{"password", "token", "secret"}
+
+// String obtains a textual representation of a Scene. If RedactByDefault is set, the values of fields with
+// sensitive names (as per SensitiveKeySubstrings) are masked.
 func (s Scene) String() string {
-	return fmt.Sprint("Scene[Fields=", s.Fields, ", Ctx=", s.Ctx, ", Err=", s.Err, "]")
+	if RedactByDefault {
+		s = s.redact(isSensitiveByDefault)
+	}
+	return s.string()
+}
+
+// RedactedString obtains a textual representation of a Scene, in which the values of the named fields are masked.
+// The scene itself is left unchanged.
+func (s Scene) RedactedString(sensitiveKeys ...string) string {
+	return s.redact(func(key string) bool {
+		for _, sensitiveKey := range sensitiveKeys {
+			if key == sensitiveKey {
+				return true
+			}
+		}
+		return false
+	}).string()
 }
 
-// IsSet returns true if the scene, meaning it has at least one field specified, a context set or carries an error.
+func (s Scene) string() string {
+	return fmt.Sprint("Scene[Fields=", s.Fields, ", Attrs=", s.Attrs, ", Ctx=", s.Ctx, ", Err=", s.Err, ", RequestID=", s.RequestID, "]")
+}
+
+func isSensitiveByDefault(key string) bool {
+	lowerKey := strings.ToLower(key)
+	for _, substr := range SensitiveKeySubstrings {
+		if strings.Contains(lowerKey, substr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns a copy of the scene with the values of all fields satisfying the sensitive predicate masked.
+func (s Scene) redact(sensitive func(key string) bool) Scene {
+	redacted := s.Clone()
+	for k := range redacted.Fields {
+		if sensitive(k) {
+			redacted.Fields[k] = RedactedValue
+		}
+	}
+	for i, attr := range redacted.Attrs {
+		if sensitive(attr.Key) {
+			redacted.Attrs[i].Value = RedactedValue
+		}
+	}
+	return redacted
+}
+
+// IsSet returns true if the scene, meaning it has at least one field specified, a context set, carries an error or
+// a request ID.
 func (s Scene) IsSet() bool {
-	return len(s.Fields) > 0 || s.Ctx != nil || s.Err != nil
+	return len(s.Fields) > 0 || len(s.Attrs) > 0 || s.Ctx != nil || s.Err != nil || s.RequestID != ""
+}
+
+// WithRequestID returns a copy of the scene with the given request ID, for correlating log entries across a
+// request. For example:
+//
+//	s.Capture(scribe.Scene{}.WithRequestID(id)).I()("Handling request")
+func (s Scene) WithRequestID(id string) Scene {
+	s.RequestID = id
+	return s
+}
+
+// AllFields returns the scene's fields (including those in Attrs), supplemented with the request ID (if set) keyed
+// under RequestIDFieldKey and, if RenderErrorChain is set, the chain of errors wrapped by Err (if any) keyed under
+// ErrorChainFieldKey. These take precedence over any fields of the same name. This is the set of fields that
+// bindings emit. If there is nothing to supplement, the scene's Fields map is returned as-is; otherwise, a copy is
+// returned.
+func (s Scene) AllFields() Fields {
+	var chain []string
+	if RenderErrorChain {
+		chain = ErrorChain(s.Err)
+	}
+	if s.RequestID == "" && len(chain) == 0 && len(s.Attrs) == 0 {
+		return s.Fields
+	}
+
+	fields := make(Fields, len(s.Fields)+len(s.Attrs)+2)
+	for k, v := range s.Fields {
+		fields[k] = v
+	}
+	for _, attr := range s.Attrs {
+		fields[attr.Key] = attr.Value
+	}
+	if s.RequestID != "" {
+		fields[RequestIDFieldKey] = s.RequestID
+	}
+	if len(chain) > 0 {
+		fields[ErrorChainFieldKey] = chain
+	}
+	return fields
+}
+
+// ErrorChain returns the messages of the errors wrapped by err, obtained by repeatedly applying errors.Unwrap,
+// starting with the immediate cause. The message of err itself is not included. Returns nil if err is nil or does
+// not wrap another error.
+func ErrorChain(err error) []string {
+	var chain []string
+	for cause := errors.Unwrap(err); cause != nil; cause = errors.Unwrap(cause) {
+		chain = append(chain, cause.Error())
+	}
+	return chain
+}
+
+// Clone returns a copy of the scene, in which the Fields map and the Attrs list are copied, such that mutating the
+// fields of one scene does not affect the other. The field values themselves, as well as Ctx and Err, are copied by
+// reference. A nil Fields map (or Attrs list) remains nil in the clone.
+func (s Scene) Clone() Scene {
+	if s.Fields != nil {
+		fields := make(Fields, len(s.Fields))
+		for k, v := range s.Fields {
+			fields[k] = v
+		}
+		s.Fields = fields
+	}
+	if s.Attrs != nil {
+		s.Attrs = append(make(Attrs, 0, len(s.Attrs)), s.Attrs...)
+	}
+	return s
+}
+
+// Merge returns a new scene that combines the contents of this scene with other, leaving both scenes unchanged.
+// The other scene takes precedence: where both scenes contain a field with the same name, the value in other is
+// used, and the Attrs of other are appended to those of this scene. Similarly, a non-nil Ctx or Err, or a non-empty
+// RequestID, in other replaces the corresponding attribute of this scene.
+func (s Scene) Merge(other Scene) Scene {
+	merged := s.Clone()
+	if len(other.Fields) > 0 {
+		if merged.Fields == nil {
+			merged.Fields = make(Fields, len(other.Fields))
+		}
+		for k, v := range other.Fields {
+			merged.Fields[k] = v
+		}
+	}
+	if len(other.Attrs) > 0 {
+		merged.Attrs = append(merged.Attrs, other.Attrs...)
+	}
+	if other.Ctx != nil {
+		merged.Ctx = other.Ctx
+	}
+	if other.Err != nil {
+		merged.Err = other.Err
+	}
+	if other.RequestID != "" {
+		merged.RequestID = other.RequestID
+	}
+	return merged
 }
 
 // LoggerFactory specifies the behaviour for constructing a logger instance. The log factory is called upon each time
@@ -169,11 +362,21 @@ type sceneStub struct {
 // StdLogAPI represents the standard way of interacting with Scribe.
 type StdLogAPI interface {
 	L(level Level) Logger
+	At(level Level) Logger
 	T() Logger
 	D() Logger
 	I() Logger
 	W() Logger
 	E() Logger
+	MsgLogger(level Level) func(msg string)
+	WouldLog(level Level) bool
+}
+
+// SceneCapturer is implemented by loggers that can capture scene metadata in preparation for a subsequent logging
+// call. Both a Scribe and the StdLogAPI returned by its Capture method implement it; in the latter case, the newly
+// captured scene is merged into the one already captured.
+type SceneCapturer interface {
+	Capture(scene Scene) StdLogAPI
 }
 
 // Scribe is the starting point for invoking a logger. There is no concept of a default Scribe logger; one
@@ -183,12 +386,40 @@ type Scribe interface {
 	StdLogAPI
 	Enabled() Level
 	SetEnabled(level Level)
+	WithEnabled(level Level) (restore func())
+	Mute(levels ...Level)
+	Unmute(levels ...Level)
 	Capture(scene Scene) StdLogAPI
+	CaptureAttrs(attrs ...Attr) StdLogAPI
+	Reconfigure(facs LoggerFactories, reporter ...LevelReporter)
+}
+
+// LevelReporter is an optional companion to a binding that performs its own level gating, reporting whether the
+// underlying logger has a given level enabled. When supplied to New (or Reconfigure), Scribe skips invoking the
+// factories for levels that the underlying logger would discard, saving the cost of constructing a logger (and of
+// enriching it with the scene) only for it to no-op.
+type LevelReporter interface {
+	LevelEnabled(level Level) bool
+}
+
+// LevelReporterFunc adapts an ordinary function to the LevelReporter interface.
+type LevelReporterFunc func(level Level) bool
+
+// LevelEnabled invokes the underlying function.
+func (f LevelReporterFunc) LevelEnabled(level Level) bool {
+	return f(level)
 }
 
 type scribe struct {
-	facs    LoggerFactories
-	enabled Level
+	config  atomic.Value // config
+	enabled uint32       // Level, accessed atomically
+	muted   [4]uint64    // bit set of muted Levels, accessed atomically
+}
+
+// The factories and the (optional) level reporter, which are replaced together upon reconfiguration.
+type config struct {
+	facs     LoggerFactories
+	reporter LevelReporter
 }
 
 var nopFac = Fac(Nop)
@@ -204,6 +435,17 @@ func Fac(logger Logger) LoggerFactory {
 // Nop is a no-op logger function.
 func Nop(_ string, _ ...interface{}) {}
 
+// Must returns the given facs if err is nil, and panics with err otherwise. It is intended for wrapping calls to
+// binding constructors that may fail, such that the result can be passed directly to New. For example:
+//
+//	s := scribe.New(scribe.Must(SomeBinding()))
+func Must(facs LoggerFactories, err error) LoggerFactories {
+	if err != nil {
+		panic(err)
+	}
+	return facs
+}
+
 // New constructs a Scribe instance from the given facs configuration.
 //
 // The supplied facs maps a supported log level to a corresponding LoggerFactory. Factories may be supplied individually
@@ -213,7 +455,35 @@ func Nop(_ string, _ ...interface{}) {}
 //
 // Custom log levels are supported by supplying a mapping for a custom Level. However, the default LogFactory specified
 // for the All level does not apply to custom levels. In other words, each custom level requires an explicit LogFactory.
-func New(facs LoggerFactories) Scribe {
+//
+// An optional LevelReporter may be supplied for bindings that gate levels of their own accord, in which case no
+// factory is invoked for a level that the reporter deems disabled.
+func New(facs LoggerFactories, reporter ...LevelReporter) Scribe {
+	s := &scribe{enabled: uint32(DefaultEnabledLevel)}
+	s.storeConfig(facs, reporter)
+	return s
+}
+
+// Reconfigure atomically replaces the factories used by this Scribe, such that subsequent log calls are routed to the
+// new factories. The enabled level is unaffected. This is useful for applications that reload their logging
+// configuration at runtime (for example, on SIGHUP).
+//
+// The supplied facs are validated in the same manner as in New, panicking if a built-in level is not configured and
+// no default is specified for All; in that case, the existing configuration is retained. Loggers that were obtained
+// prior to reconfiguration remain bound to the factories that produced them.
+//
+// The optional LevelReporter replaces any that was supplied previously; if omitted, no reporter is used.
+func (s *scribe) Reconfigure(facs LoggerFactories, reporter ...LevelReporter) {
+	s.storeConfig(facs, reporter)
+}
+
+func (s *scribe) storeConfig(facs LoggerFactories, reporter []LevelReporter) {
+	r, _ := arity.SoleUntyped(nil, reporter).(LevelReporter)
+	s.config.Store(config{expandFacs(facs), r})
+}
+
+// Expands the given facs, applying the default factory for All to the unconfigured built-in levels.
+func expandFacs(facs LoggerFactories) LoggerFactories {
 	var defFac = facs[All]
 
 	expandedFacs := LoggerFactories{}
@@ -236,8 +506,7 @@ func New(facs LoggerFactories) Scribe {
 			expandedFacs[l.Level] = defFac
 		}
 	}
-
-	return &scribe{expandedFacs, DefaultEnabledLevel}
+	return expandedFacs
 }
 
 // Capture contextual scene metadata for passing onto the underlying logger, in preparation for a
@@ -246,16 +515,92 @@ func (s *scribe) Capture(scene Scene) StdLogAPI {
 	return &sceneStub{s, scene}
 }
 
+// CaptureAttrs captures an ordered list of attributes, in preparation for a subsequent logging call. It is
+// shorthand for Capture(Scene{Attrs: attrs}).
+func (s *scribe) CaptureAttrs(attrs ...Attr) StdLogAPI {
+	return s.Capture(Scene{Attrs: attrs})
+}
+
 // Enabled returns the most fine-grained log level that is enabled. By implication, all levels that are coarser
 // than the returned level are also enabled.
 func (s *scribe) Enabled() Level {
-	return s.enabled
+	return Level(atomic.LoadUint32(&s.enabled))
 }
 
 // SetEnabled enables logging at the given level. By implication, all levels that are coarser
 // than the supplied level are also enabled.
 func (s *scribe) SetEnabled(level Level) {
-	s.enabled = level
+	atomic.StoreUint32(&s.enabled, uint32(level))
+}
+
+// WithEnabled enables logging at the given level, returning a function that restores the previously enabled
+// level. It is useful for temporarily changing the level for the duration of a scoped operation or a test:
+//
+//	defer s.WithEnabled(All)()
+//
+// The restore function reinstates the level that was in effect when WithEnabled was called, overriding any changes
+// made in the interim.
+func (s *scribe) WithEnabled(level Level) (restore func()) {
+	previous := atomic.SwapUint32(&s.enabled, uint32(level))
+	return func() {
+		atomic.StoreUint32(&s.enabled, previous)
+	}
+}
+
+// Mute suppresses logging at the given levels, even if they are at or above the enabled level. This allows for
+// configurations that cannot be expressed by a threshold alone — for example, everything except Warn:
+//
+//	s.SetEnabled(All)
+//	s.Mute(Warn)
+//
+// The muted levels are overlaid on the threshold: changing the enabled level (via SetEnabled or WithEnabled) neither
+// clears nor alters them, and a level below the enabled level remains disabled regardless of whether it is muted.
+// Muting a level that is already muted has no effect.
+func (s *scribe) Mute(levels ...Level) {
+	for _, level := range levels {
+		s.updateMuted(level, true)
+	}
+}
+
+// Unmute reverses the effect of Mute for the given levels, which are then subject to the enabled level as usual.
+// Unmuting a level that is not muted has no effect.
+func (s *scribe) Unmute(levels ...Level) {
+	for _, level := range levels {
+		s.updateMuted(level, false)
+	}
+}
+
+func (s *scribe) isMuted(level Level) bool {
+	return atomic.LoadUint64(&s.muted[level/64])&(1<<(level%64)) != 0
+}
+
+func (s *scribe) updateMuted(level Level, muted bool) {
+	word, bit := &s.muted[level/64], uint64(1)<<(level%64)
+	for {
+		existing := atomic.LoadUint64(word)
+		updated := existing &^ bit
+		if muted {
+			updated = existing | bit
+		}
+		if atomic.CompareAndSwapUint64(word, existing, updated) {
+			return
+		}
+	}
+}
+
+// WouldLog returns true if a message logged at the given level would actually be emitted, given the currently
+// enabled level, the muted levels and the LevelReporter (if one was supplied). It is useful for skipping the
+// construction of expensive log arguments (such as a Fields map for Capture) when the message would be discarded
+// anyway.
+func (s *scribe) WouldLog(level Level) bool {
+	if level < s.Enabled() || level == Off || s.isMuted(level) {
+		return false
+	}
+	cfg := s.loadConfig()
+	if _, ok := cfg.facs[level]; !ok {
+		return false
+	}
+	return cfg.reporter == nil || cfg.reporter.LevelEnabled(level)
 }
 
 // L obtains a logger function for the supplied level. This method is the long form of calling T(), D(), I(), etc.,
@@ -266,11 +611,46 @@ func (s *scribe) L(level Level) Logger {
 	return s.fac(level)(level, Scene{})
 }
 
-// T is the short form of L(Trace), returning a logger for the Trace level.
-func (s *scribe) T() Logger { return s.L(Trace) }
+// At is an alias for L, reading more naturally at call sites where the level is computed.
+func (s *scribe) At(level Level) Logger {
+	return s.L(level)
+}
 
-// D is the short form of L(Debug), returning a logger for the Debug level.
-func (s *scribe) D() Logger { return s.L(Debug) }
+// MsgLogger obtains a logger for pre-formatted messages at the given level, which logs each message verbatim, without
+// printf-style processing. Messages are routed through the factory for the level, so that bindings apply as usual.
+// Unlike L(level)(msg), a message containing '%' verbs is logged unchanged.
+//
+// The returned function wraps the level's logger, adding one frame to the call stack. WithCallerFunc recognises and
+// skips this frame; however, bindings that locate the call site at a fixed stack depth (such as those for Glog,
+// Seelog and Log15) report the wrapper instead. Where the call site matters to such a binding, use
+// L(level)("%s", msg).
+func (s *scribe) MsgLogger(level Level) func(msg string) {
+	return verbatim(s.L(level))
+}
+
+// Adapts a logger to log pre-formatted messages verbatim. WithCallerFunc identifies the frame of the returned
+// function by its name, so all verbatim logging must go through here.
+func verbatim(logger Logger) func(msg string) {
+	return func(msg string) {
+		logger("%s", msg)
+	}
+}
+
+// T is the short form of L(Trace), returning a logger for the Trace level. Returns Nop if TraceCompiledOut.
+func (s *scribe) T() Logger {
+	if TraceCompiledOut {
+		return Nop
+	}
+	return s.L(Trace)
+}
+
+// D is the short form of L(Debug), returning a logger for the Debug level. Returns Nop if TraceCompiledOut.
+func (s *scribe) D() Logger {
+	if TraceCompiledOut {
+		return Nop
+	}
+	return s.L(Debug)
+}
 
 // I is the short form of L(Info), returning a logger for the Info level.
 func (s *scribe) I() Logger { return s.L(Info) }
@@ -281,12 +661,20 @@ func (s *scribe) W() Logger { return s.L(Warn) }
 // E is the short form of L(Error), returning a logger for the Error level.
 func (s *scribe) E() Logger { return s.L(Error) }
 
+func (s *scribe) loadConfig() config {
+	return s.config.Load().(config)
+}
+
 // Retrieves a LoggerFactory for the specified level.
 func (s *scribe) fac(level Level) LoggerFactory {
-	if level < s.enabled {
+	if level < s.Enabled() || s.isMuted(level) {
 		return nopFac
 	}
-	if loggerFac, ok := s.facs[level]; ok {
+	cfg := s.loadConfig()
+	if loggerFac, ok := cfg.facs[level]; ok {
+		if cfg.reporter != nil && !cfg.reporter.LevelEnabled(level) {
+			return nopFac
+		}
 		return loggerFac
 	}
 
@@ -294,15 +682,48 @@ func (s *scribe) fac(level Level) LoggerFactory {
 	panic(fmt.Errorf("missing logger factory for level %s", level.String()))
 }
 
+// WouldLog returns true if a message logged at the given level would actually be emitted, given the currently
+// enabled level of the parent Scribe.
+func (ss *sceneStub) WouldLog(level Level) bool {
+	return ss.s.WouldLog(level)
+}
+
+// Capture merges the given scene into the captured scene, in preparation for a subsequent logging call. The
+// given scene takes precedence, as per Scene.Merge.
+func (ss *sceneStub) Capture(scene Scene) StdLogAPI {
+	return &sceneStub{ss.s, ss.scene.Merge(scene)}
+}
+
 func (ss *sceneStub) L(level Level) Logger {
 	return ss.s.fac(level)(level, ss.scene)
 }
 
-// T is the short form of L(Trace), returning a logger for the Trace level.
-func (ss *sceneStub) T() Logger { return ss.L(Trace) }
+// At is an alias for L, returning a logger for a dynamically chosen level that retains the captured scene.
+func (ss *sceneStub) At(level Level) Logger {
+	return ss.L(level)
+}
+
+// MsgLogger obtains a logger for pre-formatted messages at the given level, retaining the captured scene. See
+// Scribe.MsgLogger.
+func (ss *sceneStub) MsgLogger(level Level) func(msg string) {
+	return verbatim(ss.L(level))
+}
+
+// T is the short form of L(Trace), returning a logger for the Trace level. Returns Nop if TraceCompiledOut.
+func (ss *sceneStub) T() Logger {
+	if TraceCompiledOut {
+		return Nop
+	}
+	return ss.L(Trace)
+}
 
-// D is the short form of L(Debug), returning a logger for the Debug level.
-func (ss *sceneStub) D() Logger { return ss.L(Debug) }
+// D is the short form of L(Debug), returning a logger for the Debug level. Returns Nop if TraceCompiledOut.
+func (ss *sceneStub) D() Logger {
+	if TraceCompiledOut {
+		return Nop
+	}
+	return ss.L(Debug)
+}
 
 // I is the short form of L(Info), returning a logger for the Info level.
 func (ss *sceneStub) I() Logger { return ss.L(Info) }
@@ -1,6 +1,8 @@
 package scribe
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -79,6 +81,10 @@ func TestBasicInit(t *testing.T) {
 }
 
 func TestMultipleLevels(tst *testing.T) {
+	if TraceCompiledOut {
+		tst.Skip("T() and D() are compiled out by the scribe_notrace tag")
+	}
+
 	t := logCapture{}
 	d := logCapture{}
 	i := logCapture{}
@@ -217,3 +223,424 @@ func TestName(t *testing.T) {
 		assert.Equal(t, err, c.expectedError)
 	}
 }
+
+func TestSceneClone(t *testing.T) {
+	orig := Scene{Fields: Fields{"foo": "bar"}, Ctx: context.Background(), Err: check.ErrSimulated}
+	clone := orig.Clone()
+	assert.Equal(t, orig, clone)
+
+	clone.Fields["foo"] = "baz"
+	clone.Fields["qux"] = "quux"
+	assert.Equal(t, Fields{"foo": "bar"}, orig.Fields)
+
+	assert.Nil(t, Scene{}.Clone().Fields)
+}
+
+func TestSceneMerge(t *testing.T) {
+	ctx := context.Background()
+	otherErr := errors.New("other")
+	s := Scene{Fields: Fields{"a": 1, "b": 2}, Ctx: ctx, Err: check.ErrSimulated}
+
+	// Fields in other take precedence, as do non-nil Ctx and Err.
+	otherCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	merged := s.Merge(Scene{Fields: Fields{"b": 3, "c": 4}, Ctx: otherCtx, Err: otherErr})
+	assert.Equal(t, Scene{Fields: Fields{"a": 1, "b": 3, "c": 4}, Ctx: otherCtx, Err: otherErr}, merged)
+	assert.Equal(t, Fields{"a": 1, "b": 2}, s.Fields)
+
+	// Nil Ctx and Err in other do not override.
+	merged = s.Merge(Scene{})
+	assert.Equal(t, s, merged)
+	merged.Fields["a"] = 5
+	assert.Equal(t, 1, s.Fields["a"])
+
+	// Merging into an empty scene.
+	merged = Scene{}.Merge(Scene{Fields: Fields{"a": 1}})
+	assert.Equal(t, Scene{Fields: Fields{"a": 1}}, merged)
+}
+
+func TestWouldLog(t *testing.T) {
+	const custom Level = 45
+	l := New(LoggerFactories{All: nopFac, custom: nopFac})
+	stub := l.Capture(Scene{Fields: Fields{"foo": "bar"}})
+
+	cases := []struct {
+		enabled Level
+		expect  map[Level]bool
+	}{
+		{All, map[Level]bool{Trace: true, Debug: true, Info: true, Warn: true, Error: true, custom: true, Off: false}},
+		{Trace, map[Level]bool{Trace: true, Debug: true, Info: true, Warn: true, Error: true, custom: true, Off: false}},
+		{Info, map[Level]bool{Trace: false, Debug: false, Info: true, Warn: true, Error: true, custom: true, Off: false}},
+		{Error, map[Level]bool{Trace: false, Debug: false, Info: false, Warn: false, Error: true, custom: false, Off: false}},
+		{Off, map[Level]bool{Trace: false, Debug: false, Info: false, Warn: false, Error: false, custom: false, Off: false}},
+	}
+
+	for _, c := range cases {
+		l.SetEnabled(c.enabled)
+		for level, expect := range c.expect {
+			t := check.Intercept(t).Mutate(check.Appendf("enabled=%v, level=%v", c.enabled, level))
+			assert.Equal(t, expect, l.WouldLog(level))
+			assert.Equal(t, expect, stub.WouldLog(level))
+		}
+	}
+
+	// A level without a factory would not be logged.
+	l.SetEnabled(All)
+	assert.False(t, l.WouldLog(75))
+}
+
+func TestSceneRedactedString(t *testing.T) {
+	scene := Scene{Fields: Fields{"user": "alice", "password": "hunter2", "apiToken": "xyz"}}
+	str := scene.RedactedString("password", "apiToken")
+	assert.Contains(t, str, "user:alice")
+	assert.Contains(t, str, "password:[REDACTED]")
+	assert.Contains(t, str, "apiToken:[REDACTED]")
+	assert.NotContains(t, str, "hunter2")
+	assert.NotContains(t, str, "xyz")
+
+	// The original scene is unaffected.
+	assert.Equal(t, "hunter2", scene.Fields["password"])
+
+	// Only the named keys are masked.
+	str = scene.RedactedString("user")
+	assert.Contains(t, str, "user:[REDACTED]")
+	assert.Contains(t, str, "password:hunter2")
+}
+
+func TestSceneString_redactByDefault(t *testing.T) {
+	scene := Scene{Fields: Fields{"user": "alice", "Password": "hunter2", "apiToken": "xyz", "clientSecret": "abc"}}
+	assert.Contains(t, scene.String(), "hunter2")
+
+	defer func(redact bool) { RedactByDefault = redact }(RedactByDefault)
+	RedactByDefault = true
+	str := scene.String()
+	assert.Contains(t, str, "user:alice")
+	assert.Contains(t, str, "Password:[REDACTED]")
+	assert.Contains(t, str, "apiToken:[REDACTED]")
+	assert.Contains(t, str, "clientSecret:[REDACTED]")
+	assert.Equal(t, "hunter2", scene.Fields["Password"])
+	assert.Equal(t, "Scene[Fields=map[], Attrs=[], Ctx=<nil>, Err=<nil>, RequestID=]", Scene{}.String())
+}
+
+func TestMust_passThrough(t *testing.T) {
+	facs := LoggerFactories{All: Fac(Nop)}
+	assert.Equal(t, facs, Must(facs, nil))
+}
+
+func TestMust_panic(t *testing.T) {
+	check.ThatPanicsAsExpected(t, check.CauseEqual(check.ErrSimulated), func() {
+		Must(LoggerFactories{}, check.ErrSimulated)
+	})
+}
+
+func TestAt_withScene(t *testing.T) {
+	m := NewMock()
+	l := New(m.Factories())
+	l.SetEnabled(All)
+
+	scene := Scene{Fields: Fields{"foo": "bar"}}
+	for _, level := range []Level{Trace, Info, Error} {
+		l.Capture(scene).At(level)("Message at %s", level)
+	}
+	l.At(Warn)("Message at %s", Warn)
+
+	m.Entries().Having(ASceneWith(AField("foo", "bar"))).Assert(t, Count(3))
+	m.Entries().Having(LogLevel(Info)).Having(MessageEqual("Message at Info")).
+		Having(ASceneWith(AField("foo", "bar"))).Assert(t, Count(1))
+	m.Entries().Having(LogLevel(Warn)).Having(ASceneWith(Content().Invert())).Assert(t, Count(1))
+}
+
+func TestScene_withRequestID(t *testing.T) {
+	original := Scene{Fields: Fields{"foo": "bar"}}
+	assert.False(t, Scene{}.IsSet())
+	assert.True(t, Scene{}.WithRequestID("req-1").IsSet())
+
+	scene := original.WithRequestID("req-1")
+	assert.Equal(t, "req-1", scene.RequestID)
+	assert.Equal(t, "", original.RequestID)
+	assert.Equal(t, Fields{"foo": "bar", "request_id": "req-1"}, scene.AllFields())
+	assert.Equal(t, Fields{"foo": "bar"}, scene.Fields)
+	assert.Equal(t, Fields{"foo": "bar"}, original.AllFields())
+	assert.Nil(t, Scene{}.AllFields())
+
+	assert.Equal(t, "req-2", scene.Merge(Scene{}.WithRequestID("req-2")).RequestID)
+	assert.Equal(t, "req-1", scene.Merge(Scene{}).RequestID)
+}
+
+func TestScene_withRequestIDCustomKey(t *testing.T) {
+	defer func(key string) { RequestIDFieldKey = key }(RequestIDFieldKey)
+	RequestIDFieldKey = "rid"
+	assert.Equal(t, Fields{"rid": "req-1"}, Scene{}.WithRequestID("req-1").AllFields())
+}
+
+func TestMock_requestID(t *testing.T) {
+	m := NewMock()
+	l := New(m.Factories())
+
+	l.Capture(Scene{}.WithRequestID("req-1")).I()("Handling request")
+	l.I()("Unrelated")
+	m.Entries().Having(ASceneWith(ARequestID("req-1"))).Having(MessageEqual("Handling request")).Assert(t, Count(1))
+}
+
+func TestErrorChain(t *testing.T) {
+	root := errors.New("root")
+	middle := fmt.Errorf("middle: %w", root)
+	top := fmt.Errorf("top: %w", middle)
+
+	assert.Nil(t, ErrorChain(nil))
+	assert.Nil(t, ErrorChain(root))
+	assert.Equal(t, []string{"middle: root", "root"}, ErrorChain(top))
+}
+
+func TestScene_allFieldsWithErrorChain(t *testing.T) {
+	defer func(render bool) { RenderErrorChain = render }(RenderErrorChain)
+	top := fmt.Errorf("top: %w", fmt.Errorf("middle: %w", errors.New("root")))
+	scene := Scene{Fields: Fields{"foo": "bar"}, Err: top}
+
+	assert.Equal(t, Fields{"foo": "bar"}, scene.AllFields())
+
+	RenderErrorChain = true
+	assert.Equal(t, Fields{"foo": "bar", "err_chain": []string{"middle: root", "root"}}, scene.AllFields())
+	assert.Equal(t, Fields{"foo": "bar"}, Scene{Fields: Fields{"foo": "bar"}, Err: errors.New("root")}.AllFields())
+}
+
+func TestReconfigure(t *testing.T) {
+	if TraceCompiledOut {
+		t.Skip("T() and D() are compiled out by the scribe_notrace tag")
+	}
+
+	m0 := NewMock()
+	m1 := NewMock()
+	l := New(m0.Factories())
+	l.SetEnabled(Debug)
+
+	l.I()("before")
+	l.Reconfigure(m1.Factories())
+	assert.Equal(t, Debug, l.Enabled())
+	l.I()("after")
+	l.Capture(Scene{Fields: Fields{"foo": "bar"}}).D()("after with scene")
+	l.T()("after below enabled")
+
+	m0.Entries().Assert(t, Count(1))
+	m0.Entries().Having(MessageEqual("before")).Assert(t, Count(1))
+	m1.Entries().Assert(t, Count(2))
+	m1.Entries().Having(MessageEqual("after")).Assert(t, Count(1))
+	m1.Entries().Having(ASceneWith(AField("foo", "bar"))).Assert(t, Count(1))
+}
+
+func TestLevelReporter(t *testing.T) {
+	m := NewMock()
+	reporter := LevelReporterFunc(func(level Level) bool { return level >= Warn })
+	l := New(m.Factories(), reporter)
+	// Enable every level, so that it is the reporter alone that suppresses Debug and Info.
+	l.SetEnabled(All)
+
+	l.I()("info")
+	l.Capture(Scene{Fields: Fields{"foo": "bar"}}).D()("debug with scene")
+	l.W()("warn")
+	l.E()("error")
+	m.Entries().Assert(t, Count(2))
+	m.Entries().Having(LogLevel(Warn)).Assert(t, Count(1))
+	m.Entries().Having(LogLevel(Error)).Assert(t, Count(1))
+
+	assert.False(t, l.WouldLog(Debug))
+	assert.False(t, l.WouldLog(Info))
+	assert.True(t, l.WouldLog(Warn))
+	assert.False(t, l.Capture(Scene{}).WouldLog(Debug))
+}
+
+func TestReconfigure_levelReporter(t *testing.T) {
+	m := NewMock()
+	l := New(m.Factories())
+
+	l.Reconfigure(m.Factories(), LevelReporterFunc(func(level Level) bool { return false }))
+	l.E()("suppressed")
+	m.Entries().Assert(t, Count(0))
+
+	// Omitting the reporter removes it.
+	l.Reconfigure(m.Factories())
+	l.E()("logged")
+	m.Entries().Having(MessageEqual("logged")).Assert(t, Count(1))
+}
+
+func TestReconfigure_invalid(t *testing.T) {
+	m := NewMock()
+	l := New(m.Factories())
+	check.ThatPanicsAsExpected(t, check.ErrorContaining("no default has been provided"), func() {
+		l.Reconfigure(LoggerFactories{Info: nopFac})
+	})
+
+	// The original configuration is retained.
+	l.I()("still routed")
+	m.Entries().Having(MessageEqual("still routed")).Assert(t, Count(1))
+}
+
+func TestReconfigure_concurrentWithLogging(t *testing.T) {
+	l := New(LoggerFactories{All: nopFac})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			l.I()("message %d", i)
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		l.Reconfigure(LoggerFactories{All: nopFac})
+	}
+	<-done
+}
+
+func TestTraceCompiledOut(t *testing.T) {
+	m := NewMock()
+	l := New(m.Factories())
+	l.SetEnabled(All)
+
+	l.T()("trace")
+	l.D()("debug")
+	l.Capture(Scene{}).T()("trace with scene")
+	l.Capture(Scene{}).D()("debug with scene")
+	l.L(Trace)("trace via L")
+
+	if TraceCompiledOut {
+		m.Entries().Assert(t, Count(1))
+	} else {
+		m.Entries().Assert(t, Count(5))
+	}
+	m.Entries().Having(MessageEqual("trace via L")).Assert(t, Count(1))
+}
+
+func TestMsgLogger(t *testing.T) {
+	m := NewMock()
+	l := New(m.Factories())
+	l.SetEnabled(Debug)
+
+	l.MsgLogger(Info)("100% literal %s")
+	l.Capture(Scene{Fields: Fields{"foo": "bar"}}).MsgLogger(Warn)("%d%%")
+	l.MsgLogger(Trace)("below enabled")
+
+	m.Entries().Assert(t, Count(2))
+	m.Entries().Having(LogLevel(Info)).Having(MessageEqual("100% literal %s")).Assert(t, Count(1))
+	m.Entries().Having(LogLevel(Warn)).Having(MessageEqual("%d%%")).
+		Having(ASceneWith(AField("foo", "bar"))).Assert(t, Count(1))
+}
+
+func TestWithEnabled(t *testing.T) {
+	if TraceCompiledOut {
+		t.Skip("T() and D() are compiled out by the scribe_notrace tag")
+	}
+
+	m := NewMock()
+	l := New(m.Factories())
+	l.SetEnabled(Info)
+
+	func() {
+		defer l.WithEnabled(All)()
+		assert.Equal(t, All, l.Enabled())
+		l.T()("during")
+	}()
+	assert.Equal(t, Info, l.Enabled())
+	l.T()("after")
+
+	m.Entries().Having(LogLevel(Trace)).Assert(t, Count(1))
+	m.Entries().Having(MessageEqual("during")).Assert(t, Count(1))
+}
+
+func TestWithEnabled_nested(t *testing.T) {
+	l := New(LoggerFactories{All: nopFac})
+	l.SetEnabled(Warn)
+
+	restoreOuter := l.WithEnabled(Debug)
+	restoreInner := l.WithEnabled(Off)
+	assert.Equal(t, Off, l.Enabled())
+	restoreInner()
+	assert.Equal(t, Debug, l.Enabled())
+	restoreOuter()
+	assert.Equal(t, Warn, l.Enabled())
+}
+
+func TestWithEnabled_concurrentWithLogging(t *testing.T) {
+	l := New(LoggerFactories{All: nopFac})
+	check.Hammer(t, 4, 100, func(g, i int) {
+		if g == 0 {
+			l.WithEnabled(Level(i % 70))()
+		} else {
+			l.T()("message %d", i)
+		}
+	})
+}
+
+func TestMute(t *testing.T) {
+	if TraceCompiledOut {
+		t.Skip("T() and D() are compiled out by the scribe_notrace tag")
+	}
+
+	m := NewMock()
+	l := New(m.Factories())
+	l.SetEnabled(Info)
+	l.Mute(Warn)
+	l.Mute(Warn)
+
+	l.I()("info")
+	l.W()("warn")
+	l.Capture(Scene{Fields: Fields{"foo": "bar"}}).W()("warn with scene")
+	l.E()("error")
+	m.Entries().Assert(t, Count(2))
+	m.Entries().Having(LogLevel(Info)).Assert(t, Count(1))
+	m.Entries().Having(LogLevel(Error)).Assert(t, Count(1))
+	assert.False(t, l.WouldLog(Warn))
+	assert.True(t, l.WouldLog(Error))
+
+	// Changing the enabled level leaves the mute set intact.
+	l.SetEnabled(All)
+	l.W()("warn after SetEnabled")
+	l.T()("trace")
+	m.Entries().Having(LogLevel(Warn)).Assert(t, Count(0))
+	m.Entries().Having(LogLevel(Trace)).Assert(t, Count(1))
+
+	l.Unmute(Warn, Debug)
+	l.W()("warn after Unmute")
+	m.Entries().Having(LogLevel(Warn)).Having(MessageEqual("warn after Unmute")).Assert(t, Count(1))
+	assert.True(t, l.WouldLog(Warn))
+}
+
+func TestMute_belowEnabled(t *testing.T) {
+	m := NewMock()
+	l := New(m.Factories())
+	l.SetEnabled(Error)
+	l.Mute(Info)
+	l.Unmute(Info)
+
+	// Unmuting doesn't override the threshold.
+	l.I()("info")
+	m.Entries().Assert(t, Count(0))
+}
+
+func TestMute_customLevels(t *testing.T) {
+	if TraceCompiledOut {
+		t.Skip("T() and D() are compiled out by the scribe_notrace tag")
+	}
+
+	const custom Level = 150
+	m := NewMock()
+	l := New(LoggerFactories{All: m.Factories()[All], custom: m.Factories()[All]})
+	l.Mute(custom, Trace)
+	l.L(custom)("custom")
+	l.T()("trace")
+	l.D()("debug")
+	m.Entries().Assert(t, Count(1))
+	m.Entries().Having(LogLevel(Debug)).Assert(t, Count(1))
+}
+
+func TestMute_concurrentWithLogging(t *testing.T) {
+	l := New(LoggerFactories{All: nopFac})
+	check.Hammer(t, 4, 100, func(g, i int) {
+		switch g {
+		case 0:
+			l.Mute(Level(i % 70))
+		case 1:
+			l.Unmute(Level(i % 70))
+		default:
+			l.T()("message %d", i)
+		}
+	})
+}
@@ -57,15 +57,17 @@ func (b *binding) Close() {
 }
 
 // KeyErr is used to key Scene.Err into the custom context.
+//
+// Deprecated: the binding now keys errors using scribe.ErrorFieldKey, which defaults to the same value.
 const KeyErr = "Err"
 
 func enrich(logger seelog.LoggerInterface, scene scribe.Scene) seelog.LoggerInterface {
 	m := map[string]interface{}{}
-	for k, v := range scene.Fields {
+	for k, v := range scene.AllFields() {
 		m[k] = v
 	}
 	if scene.Err != nil {
-		m[KeyErr] = scene.Err.Error()
+		m[scribe.ErrorFieldKey] = scene.Err.Error()
 	}
 	return logger
 }
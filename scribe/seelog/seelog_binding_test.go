@@ -23,6 +23,10 @@ func createBindingForWriter(w io.Writer) Binding {
 }
 
 func TestLogLevels(t *testing.T) {
+	if scribe.TraceCompiledOut {
+		t.Skip("T() and D() are compiled out by the scribe_notrace tag")
+	}
+
 	buffer := &bytes.Buffer{}
 	binding := createBindingForWriter(buffer)
 	defer binding.Close()
@@ -84,3 +88,14 @@ func TestWithScene(t *testing.T) {
 	assert.Contains(t, buffer.String(), "Charlie 3 <x:y> <simulated>")
 	buffer.Reset()
 }
+
+func TestWithScene_requestID(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	binding := createBindingForWriter(buffer)
+	defer binding.Close()
+	s := scribe.New(binding.Factories())
+
+	s.Capture(scribe.Scene{}.WithRequestID("req-1")).
+		I()("Charlie %d", 3)
+	assert.Contains(t, buffer.String(), "Charlie 3 <request_id:req-1>")
+}
@@ -0,0 +1,5 @@
+// Package syslog provides a binding for Scribe that writes to the system logger via the standard log/syslog package.
+//
+// As log/syslog is not implemented on Windows and Plan 9, the binding is only available on Unix-like platforms; on
+// other platforms, this package is empty.
+package syslog
@@ -0,0 +1,51 @@
+//go:build !windows && !plan9
+// +build !windows,!plan9
+
+package syslog
+
+import (
+	"bytes"
+	"fmt"
+	ls "log/syslog"
+
+	"github.com/obsidiandynamics/libstdgo/scribe"
+)
+
+// The subset of the *syslog.Writer API used by the binding, one method per severity.
+type writer interface {
+	Debug(m string) error
+	Info(m string) error
+	Warning(m string) error
+	Err(m string) error
+}
+
+// Bind creates a syslog binding for a given writer, as obtained from syslog.New or syslog.Dial. Scribe levels are
+// mapped to syslog severities as follows: Trace and Debug to LOG_DEBUG, Info to LOG_INFO, Warn to LOG_WARNING, and
+// Error to LOG_ERR. The severity given to the writer upon its creation is disregarded.
+//
+// As syslog has no notion of structured fields, the contents of the scene (including the error, if set) are appended
+// to the message, as per scribe.WriteScene. Errors returned by the writer are discarded.
+func Bind(w *ls.Writer) scribe.LoggerFactories {
+	return bind(w)
+}
+
+func bind(w writer) scribe.LoggerFactories {
+	return scribe.LoggerFactories{
+		scribe.Trace: fac(w.Debug),
+		scribe.Debug: fac(w.Debug),
+		scribe.Info:  fac(w.Info),
+		scribe.Warn:  fac(w.Warning),
+		scribe.Error: fac(w.Err),
+	}
+}
+
+func fac(write func(m string) error) scribe.LoggerFactory {
+	return func(level scribe.Level, scene scribe.Scene) scribe.Logger {
+		return func(format string, args ...interface{}) {
+			buffer := &bytes.Buffer{}
+			fmt.Fprintf(buffer, format, args...)
+			scribe.WriteScene(buffer, scene)
+			write(buffer.String())
+		}
+	}
+}
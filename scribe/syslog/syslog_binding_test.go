@@ -0,0 +1,99 @@
+//go:build !windows && !plan9
+// +build !windows,!plan9
+
+package syslog
+
+import (
+	"errors"
+	ls "log/syslog"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/obsidiandynamics/libstdgo/scribe"
+	"github.com/stretchr/testify/assert"
+)
+
+type entry struct {
+	severity string
+	message  string
+}
+
+type fakeWriter struct {
+	entries []entry
+}
+
+func (w *fakeWriter) record(severity string) func(m string) error {
+	return func(m string) error {
+		w.entries = append(w.entries, entry{severity, m})
+		return nil
+	}
+}
+
+func (w *fakeWriter) Debug(m string) error   { return w.record("debug")(m) }
+func (w *fakeWriter) Info(m string) error    { return w.record("info")(m) }
+func (w *fakeWriter) Warning(m string) error { return w.record("warning")(m) }
+func (w *fakeWriter) Err(m string) error     { return w.record("err")(m) }
+
+func TestLevelMapping(t *testing.T) {
+	if scribe.TraceCompiledOut {
+		t.Skip("T() and D() are compiled out by the scribe_notrace tag")
+	}
+
+	w := &fakeWriter{}
+	s := scribe.New(bind(w))
+	s.SetEnabled(scribe.All)
+
+	s.T()("Trace %d", 1)
+	s.D()("Debug %d", 2)
+	s.I()("Info %d", 3)
+	s.W()("Warn %d", 4)
+	s.E()("Error %d", 5)
+
+	assert.Equal(t, []entry{
+		{"debug", "Trace 1"},
+		{"debug", "Debug 2"},
+		{"info", "Info 3"},
+		{"warning", "Warn 4"},
+		{"err", "Error 5"},
+	}, w.entries)
+}
+
+func TestWithScene(t *testing.T) {
+	w := &fakeWriter{}
+	s := scribe.New(bind(w))
+
+	s.Capture(scribe.Scene{Fields: scribe.Fields{"x": "y"}, Err: errors.New("simulated")}).I()("Info")
+	assert.Equal(t, []entry{{"info", "Info <x:y> <simulated>"}}, w.entries)
+}
+
+func TestDialUDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer conn.Close()
+
+	w, err := ls.Dial("udp", conn.LocalAddr().String(), ls.LOG_INFO|ls.LOG_LOCAL0, "test")
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer w.Close()
+
+	s := scribe.New(Bind(w))
+	s.W()("Warn %d", 42)
+
+	buffer := make([]byte, 1024)
+	assert.Nil(t, conn.SetReadDeadline(time.Now().Add(10*time.Second)))
+	n, _, err := conn.ReadFrom(buffer)
+	if !assert.Nil(t, err) {
+		return
+	}
+	packet := string(buffer[:n])
+
+	// Priority is the facility (LOG_LOCAL0 = 16) times 8, plus the severity (LOG_WARNING = 4).
+	assert.True(t, strings.HasPrefix(packet, "<132>"), packet)
+	assert.Contains(t, packet, "test[")
+	assert.True(t, strings.HasSuffix(packet, "Warn 42\n"), packet)
+}
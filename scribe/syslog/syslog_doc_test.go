@@ -0,0 +1,28 @@
+//go:build !windows && !plan9
+// +build !windows,!plan9
+
+package syslog
+
+import (
+	ls "log/syslog"
+	"testing"
+
+	"github.com/obsidiandynamics/libstdgo/check"
+	"github.com/obsidiandynamics/libstdgo/scribe"
+)
+
+func Example() {
+	w, err := ls.New(ls.LOG_INFO|ls.LOG_USER, "myapp")
+	if err != nil {
+		panic(err)
+	}
+	defer w.Close()
+	s := scribe.New(Bind(w))
+
+	// Do some logging
+	s.I()("Important application message")
+}
+
+func TestExample(t *testing.T) {
+	check.RunTargetted(t, Example)
+}
@@ -0,0 +1,75 @@
+package scribe
+
+// LeveledSink pairs a set of LoggerFactories with the minimum level that the sink will accept. Log calls below
+// MinLevel are not routed to the sink.
+type LeveledSink struct {
+	Facs     LoggerFactories
+	MinLevel Level
+}
+
+// TeeWithLevels creates LoggerFactories that fan each log call out to multiple sinks, where each sink has its own
+// minimum level. A log call is routed only to those sinks whose MinLevel it meets. For example, a console sink
+// might accept Debug and above, while a remote sink only accepts Warn and above.
+//
+// As with New, a factory for the All level within a sink serves as the default for any built-in levels not
+// explicitly configured in that sink. Custom levels are supported, provided at least one sink configures them
+// explicitly.
+//
+// Note: the resulting logger is a shim that invokes each of the underlying loggers in turn; call site information
+// reported by the underlying loggers will reflect this.
+func TeeWithLevels(sinks ...LeveledSink) LoggerFactories {
+	facs := LoggerFactories{}
+	for _, l := range Levels {
+		if l.Level == Off || l.Level == All {
+			continue
+		}
+		facs[l.Level] = teeFac(sinks)
+	}
+	for _, sink := range sinks {
+		for level := range sink.Facs {
+			if _, ok := facs[level]; !ok && level != All && level != Off {
+				facs[level] = teeFac(sinks)
+			}
+		}
+	}
+	return facs
+}
+
+func teeFac(sinks []LeveledSink) LoggerFactory {
+	return func(level Level, scene Scene) Logger {
+		loggers := make([]Logger, 0, len(sinks))
+		for _, sink := range sinks {
+			if level < sink.MinLevel {
+				continue
+			}
+			if fac := sink.fac(level); fac != nil {
+				loggers = append(loggers, fac(level, scene))
+			}
+		}
+
+		switch len(loggers) {
+		case 0:
+			return Nop
+		case 1:
+			return loggers[0]
+		default:
+			return func(format string, args ...interface{}) {
+				for _, logger := range loggers {
+					logger(format, args...)
+				}
+			}
+		}
+	}
+}
+
+// Resolves the factory for the given level, falling back to the All factory for built-in levels. Returns nil if
+// the sink does not support the level.
+func (ls LeveledSink) fac(level Level) LoggerFactory {
+	if fac, ok := ls.Facs[level]; ok {
+		return fac
+	}
+	if _, ok := Levels[level]; ok {
+		return ls.Facs[All]
+	}
+	return nil
+}
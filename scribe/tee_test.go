@@ -0,0 +1,63 @@
+package scribe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeeWithLevels(t *testing.T) {
+	if TraceCompiledOut {
+		t.Skip("T() and D() are compiled out by the scribe_notrace tag")
+	}
+
+	debugSink := NewMock()
+	warnSink := NewMock()
+
+	s := New(TeeWithLevels(
+		LeveledSink{Facs: debugSink.Factories(), MinLevel: Debug},
+		LeveledSink{Facs: warnSink.Factories(), MinLevel: Warn},
+	))
+	s.SetEnabled(All)
+
+	s.T()("Trace")
+	debugSink.Entries().Assert(t, Count(0))
+	warnSink.Entries().Assert(t, Count(0))
+
+	s.D()("Debug")
+	debugSink.Entries().Having(LogLevel(Debug)).Assert(t, Count(1))
+	warnSink.Entries().Assert(t, Count(0))
+
+	s.Capture(Scene{Fields: Fields{"foo": "bar"}}).E()("Error %d", 42)
+	debugSink.Entries().Having(LogLevel(Error)).Having(MessageEqual("Error 42")).
+		Having(ASceneWith(AField("foo", "bar"))).Assert(t, Count(1))
+	warnSink.Entries().Having(LogLevel(Error)).Having(MessageEqual("Error 42")).
+		Having(ASceneWith(AField("foo", "bar"))).Assert(t, Count(1))
+
+	debugSink.Entries().Assert(t, Count(2))
+	warnSink.Entries().Assert(t, Count(1))
+}
+
+func TestTeeWithLevels_defaultAndCustomLevels(t *testing.T) {
+	const custom Level = 45
+
+	var allCaptured, customCaptured []string
+	capture := func(captured *[]string) LoggerFactory {
+		return Fac(func(format string, args ...interface{}) {
+			*captured = append(*captured, format)
+		})
+	}
+
+	facs := TeeWithLevels(
+		LeveledSink{Facs: LoggerFactories{All: capture(&allCaptured)}, MinLevel: Info},
+		LeveledSink{Facs: LoggerFactories{All: capture(&customCaptured), custom: capture(&customCaptured)}, MinLevel: All},
+	)
+	assert.Contains(t, facs, custom)
+	assert.NotContains(t, facs, All)
+
+	s := New(facs)
+	s.I()("info")
+	s.L(custom)("custom")
+	assert.Equal(t, []string{"info"}, allCaptured)
+	assert.Equal(t, []string{"info", "custom"}, customCaptured)
+}
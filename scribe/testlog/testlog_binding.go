@@ -0,0 +1,30 @@
+// Package testlog provides a binding for Scribe that routes log output to testing.T.
+package testlog
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/obsidiandynamics/libstdgo/scribe"
+)
+
+// Bind creates a binding that routes all levels to t.Logf, prefixing each message with the abbreviated level name
+// (or the level's ordinal, for a custom level) and appending the scene contents (as per scribe.WriteScene). The
+// output is attributed to the test, appearing when the test fails or when running 'go test -v'.
+func Bind(t testing.TB) scribe.LoggerFactories {
+	return scribe.LoggerFactories{
+		scribe.All: func(level scribe.Level, scene scribe.Scene) scribe.Logger {
+			return func(format string, args ...interface{}) {
+				t.Helper()
+				buffer := &bytes.Buffer{}
+				nameAbbr, _ := scribe.LevelNameAbbreviated(level)
+				buffer.WriteString(nameAbbr)
+				scribe.Space(buffer)
+				buffer.WriteString(fmt.Sprintf(format, args...))
+				scribe.WriteScene(buffer, scene)
+				t.Logf("%s", buffer.String())
+			}
+		},
+	}
+}
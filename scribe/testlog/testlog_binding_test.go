@@ -0,0 +1,72 @@
+package testlog
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/obsidiandynamics/libstdgo/check"
+	"github.com/obsidiandynamics/libstdgo/scribe"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockTB struct {
+	testing.TB
+	logs    []string
+	helpers int
+}
+
+func (m *mockTB) Logf(format string, args ...interface{}) {
+	m.logs = append(m.logs, fmt.Sprintf(format, args...))
+}
+
+func (m *mockTB) Helper() {
+	m.helpers++
+}
+
+func TestBind(t *testing.T) {
+	if scribe.TraceCompiledOut {
+		t.Skip("T() and D() are compiled out by the scribe_notrace tag")
+	}
+
+	m := &mockTB{}
+	s := scribe.New(Bind(m))
+	s.SetEnabled(scribe.All)
+
+	s.T()("Alpha %d", 1)
+	s.D()("Bravo %d", 2)
+	s.I()("Charlie %d", 3)
+	s.W()("Delta %d", 4)
+	s.E()("Echo %d", 5)
+	assert.Equal(t, []string{
+		"TRC Alpha 1",
+		"DBG Bravo 2",
+		"INF Charlie 3",
+		"WRN Delta 4",
+		"ERR Echo 5",
+	}, m.logs)
+	assert.Equal(t, 5, m.helpers)
+}
+
+func TestBind_withScene(t *testing.T) {
+	m := &mockTB{}
+	s := scribe.New(Bind(m))
+
+	s.Capture(scribe.Scene{Fields: scribe.Fields{"foo": "bar"}, Err: check.ErrSimulated}).W()("Delta %d", 4)
+	assert.Equal(t, []string{"WRN Delta 4 <foo:bar> <simulated>"}, m.logs)
+}
+
+func TestBind_customLevel(t *testing.T) {
+	const custom scribe.Level = 45
+	m := &mockTB{}
+	facs := Bind(m)
+	facs[custom] = facs[scribe.All]
+	s := scribe.New(facs)
+
+	s.L(custom)("Foxtrot %d", 6)
+	assert.Equal(t, []string{"<ordinal 45> Foxtrot 6"}, m.logs)
+}
+
+func TestBind_realTester(t *testing.T) {
+	s := scribe.New(Bind(t))
+	s.I()("Logged via %s", "testing.T")
+}
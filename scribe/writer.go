@@ -0,0 +1,65 @@
+package scribe
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"sync"
+)
+
+type levelWriter struct {
+	lock   sync.Mutex
+	api    StdLogAPI
+	level  Level
+	buffer bytes.Buffer
+}
+
+// WriterAt creates an io.WriteCloser that emits a log record at the given level for each newline-terminated line
+// written to it. This allows libraries that only accept an io.Writer (for example, log.New or
+// http.Server.ErrorLog) to log through Scribe. For example:
+//
+//	server := &http.Server{
+//		ErrorLog: log.New(scribe.WriterAt(s, scribe.Error), "", 0),
+//	}
+//
+// Partial lines are buffered across calls to Write until a newline is received. Closing the writer flushes any
+// remaining partial line as a final record. The line terminator (either "\n" or "\r\n") is not included in the
+// logged message.
+//
+// The returned writer is safe for concurrent use; each line is logged in its entirety.
+func WriterAt(s StdLogAPI, level Level) io.WriteCloser {
+	return &levelWriter{api: s, level: level}
+}
+
+// Write buffers the given bytes, logging each complete line.
+func (w *levelWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.buffer.Write(p)
+	for {
+		i := bytes.IndexByte(w.buffer.Bytes(), '\n')
+		if i < 0 {
+			break
+		}
+		line := string(w.buffer.Next(i + 1))
+		w.emit(strings.TrimSuffix(line[:i], "\r"))
+	}
+	return len(p), nil
+}
+
+// Close logs any buffered partial line.
+func (w *levelWriter) Close() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.buffer.Len() > 0 {
+		w.emit(w.buffer.String())
+		w.buffer.Reset()
+	}
+	return nil
+}
+
+func (w *levelWriter) emit(line string) {
+	w.api.L(w.level)("%s", line)
+}
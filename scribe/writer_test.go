@@ -0,0 +1,67 @@
+package scribe
+
+import (
+	"io"
+	"log"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriterAt_multiline(t *testing.T) {
+	m := NewMock()
+	s := New(m.Factories())
+	w := WriterAt(s, Warn)
+
+	n, err := io.WriteString(w, "alpha\nbravo\r\n\ncharlie\n")
+	assert.Equal(t, 22, n)
+	assert.Nil(t, err)
+
+	m.Entries().Having(LogLevel(Warn)).Assert(t, Count(4))
+	assert.Equal(t, []string{"alpha", "bravo", "", "charlie"}, formattedMessages(m))
+}
+
+func TestWriterAt_partial(t *testing.T) {
+	m := NewMock()
+	s := New(m.Factories())
+	w := WriterAt(s.Capture(Scene{Fields: Fields{"foo": "bar"}}), Info)
+
+	io.WriteString(w, "al")
+	io.WriteString(w, "pha\nbra")
+	m.Entries().Assert(t, Count(1))
+	io.WriteString(w, "vo\ncharl")
+	m.Entries().Assert(t, Count(2))
+
+	assert.Nil(t, w.Close())
+	m.Entries().Having(LogLevel(Info)).Having(ASceneWith(AField("foo", "bar"))).Assert(t, Count(3))
+	assert.Equal(t, []string{"alpha", "bravo", "charl"}, formattedMessages(m))
+
+	// Closing again with nothing buffered has no effect.
+	assert.Nil(t, w.Close())
+	m.Entries().Assert(t, Count(3))
+}
+
+func TestWriterAt_withStdLog(t *testing.T) {
+	m := NewMock()
+	s := New(m.Factories())
+	logger := log.New(WriterAt(s, Error), "prefix: ", 0)
+
+	logger.Printf("value %d", 42)
+	m.Entries().Having(LogLevel(Error)).Having(MessageEqual("prefix: value 42")).Assert(t, Count(1))
+}
+
+func TestWriterAt_verbsNotInterpreted(t *testing.T) {
+	m := NewMock()
+	s := New(m.Factories())
+	io.WriteString(WriterAt(s, Info), "100% %d\n")
+	assert.Equal(t, []string{"100% %d"}, formattedMessages(m))
+}
+
+func formattedMessages(m MockScribe) []string {
+	list := m.Entries().List()
+	messages := make([]string, len(list))
+	for i, entry := range list {
+		messages[i] = entry.FormattedMessage()
+	}
+	return messages
+}
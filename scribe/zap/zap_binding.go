@@ -9,14 +9,16 @@ import (
 )
 
 // KeyErr is used to key Scene.Err into the custom logging context.
+//
+// Deprecated: the binding now keys errors using scribe.ErrorFieldKey, which defaults to the same value.
 const KeyErr = "Err"
 
 func enrich(sug *zap.SugaredLogger, scene scribe.Scene) *zap.SugaredLogger {
-	for k, v := range scene.Fields {
-		sug = sug.With(k, fmt.Sprint(v))
+	for _, attr := range scene.OrderedFields() {
+		sug = sug.With(attr.Key, fmt.Sprint(attr.Value))
 	}
 	if scene.Err != nil {
-		sug = sug.With(KeyErr, scene.Err.Error())
+		sug = sug.With(scribe.ErrorFieldKey, scene.Err.Error())
 	}
 	return sug
 }
@@ -2,6 +2,8 @@ package zap
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/obsidiandynamics/libstdgo/check"
@@ -20,6 +22,10 @@ func (b *syncBuffer) Sync() error {
 }
 
 func TestLogLevels(t *testing.T) {
+	if scribe.TraceCompiledOut {
+		t.Skip("T() and D() are compiled out by the scribe_notrace tag")
+	}
+
 	buffer := &syncBuffer{}
 	core := zapcore.NewCore(zapcore.NewConsoleEncoder(zap.NewDevelopmentEncoderConfig()), buffer, zapcore.DebugLevel)
 	zap := zap.New(core).WithOptions(zap.AddCaller())
@@ -84,3 +90,56 @@ func TestWithScene(t *testing.T) {
 	assert.Contains(t, buffer.String(), "Charlie 3")
 	buffer.Reset()
 }
+
+func TestWithScene_customErrorFieldKey(t *testing.T) {
+	defer func(key string) { scribe.ErrorFieldKey = key }(scribe.ErrorFieldKey)
+	scribe.ErrorFieldKey = "error"
+
+	buffer := &syncBuffer{}
+	core := zapcore.NewCore(zapcore.NewConsoleEncoder(zap.NewDevelopmentEncoderConfig()), buffer, zapcore.DebugLevel)
+	zap := zap.New(core)
+	s := scribe.New(Bind(zap.Sugar()))
+
+	s.Capture(scribe.Scene{Err: check.ErrSimulated}).
+		I()("Charlie %d", 3)
+	assert.Contains(t, buffer.String(), `"error": "simulated"`)
+	assert.NotContains(t, buffer.String(), `"Err"`)
+}
+
+func TestWithScene_requestID(t *testing.T) {
+	buffer := &syncBuffer{}
+	core := zapcore.NewCore(zapcore.NewConsoleEncoder(zap.NewDevelopmentEncoderConfig()), buffer, zapcore.DebugLevel)
+	zap := zap.New(core)
+	s := scribe.New(Bind(zap.Sugar()))
+
+	s.Capture(scribe.Scene{}.WithRequestID("req-1")).
+		I()("Charlie %d", 3)
+	assert.Contains(t, buffer.String(), `"request_id": "req-1"`)
+}
+
+func TestWithScene_errorChain(t *testing.T) {
+	defer func(render bool) { scribe.RenderErrorChain = render }(scribe.RenderErrorChain)
+	scribe.RenderErrorChain = true
+
+	buffer := &syncBuffer{}
+	core := zapcore.NewCore(zapcore.NewConsoleEncoder(zap.NewDevelopmentEncoderConfig()), buffer, zapcore.DebugLevel)
+	zap := zap.New(core)
+	s := scribe.New(Bind(zap.Sugar()))
+
+	err := fmt.Errorf("top: %w", fmt.Errorf("middle: %w", errors.New("root")))
+	s.Capture(scribe.Scene{Err: err}).
+		I()("Charlie %d", 3)
+	assert.Contains(t, buffer.String(), `"Err": "top: middle: root"`)
+	assert.Contains(t, buffer.String(), `"err_chain": "[middle: root root]"`)
+}
+
+func TestWithScene_orderedAttrs(t *testing.T) {
+	buffer := &syncBuffer{}
+	core := zapcore.NewCore(zapcore.NewConsoleEncoder(zap.NewDevelopmentEncoderConfig()), buffer, zapcore.DebugLevel)
+	zap := zap.New(core)
+	s := scribe.New(Bind(zap.Sugar()))
+
+	s.CaptureAttrs(scribe.Attr{Key: "zulu", Value: 1}, scribe.Attr{Key: "alpha", Value: 2}, scribe.Attr{Key: "mike", Value: 3}).
+		I()("Charlie %d", 3)
+	assert.Contains(t, buffer.String(), `{"zulu": "1", "alpha": "2", "mike": "3"}`)
+}